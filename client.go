@@ -3,22 +3,44 @@ package client
 import (
 	"context"
 	"crypto/ecdsa"
+	"errors"
+	"fmt"
+	"math/big"
 	"time"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/ethclient"
 
+	"github.com/tselementes/dydx-v3-go/constants"
+	"github.com/tselementes/dydx-v3-go/eth"
+	"github.com/tselementes/dydx-v3-go/ethprivate"
+	"github.com/tselementes/dydx-v3-go/ethsigning"
+	"github.com/tselementes/dydx-v3-go/onboarding"
 	"github.com/tselementes/dydx-v3-go/private"
 	"github.com/tselementes/dydx-v3-go/public"
+	"github.com/tselementes/dydx-v3-go/starkex"
+	"github.com/tselementes/dydx-v3-go/types"
 )
 
 type Client struct {
-	host    string
-	chainId int
+	host          string
+	chainId       int
+	ethPrivateKey *ecdsa.PrivateKey
+	starkSigner   *starkex.Signer
+	// The STARK public key is kept apart from the signer since on-chain
+	// calls only need the public key, which may be provided without the
+	// private key.
+	starkPublicKey            string
+	starkPublicKeyYCoordinate string
 
-	ethClient  *ethclient.Client
-	pubClient  *public.Client
-	privClient *private.Client
+	ethClient        *ethclient.Client
+	pubClient        *public.Client
+	privClient       *private.Client
+	onboardingClient *onboarding.Client
+	ethPrivClient    *ethprivate.Client
+
+	perpetuals      *eth.StarkwarePerpetuals
+	collateralToken *eth.ERC20
 }
 
 func New(
@@ -39,6 +61,19 @@ func New(
 		return nil, err
 	}
 
+	// The STARK private key is only required for signing L2 actions,
+	// so it is fine for it to be missing.
+	var starkSigner *starkex.Signer
+	starkPublicKeyYCoordinate := starkPrivateKeyYCoordinate
+	if starkPrivateKey != "" {
+		starkSigner, err = starkex.NewSigner(starkPublicKey, starkPrivateKey, starkPrivateKeyYCoordinate)
+		if err != nil {
+			return nil, err
+		}
+		starkPublicKey = starkSigner.PublicKey()
+		starkPublicKeyYCoordinate = starkSigner.PublicKeyYCoordinate()
+	}
+
 	pubClient, err := public.New(host, timeout)
 	if err != nil {
 		return nil, err
@@ -48,7 +83,7 @@ func New(
 		host,
 		timeout,
 		chainId,
-		starkPrivateKey,
+		starkSigner,
 		defaultEthereumAddress,
 		apiKeyCredentials,
 	)
@@ -56,12 +91,183 @@ func New(
 		return nil, err
 	}
 
+	// Onboarding and API key management are authenticated
+	// with the Ethereum key.
+	var onboardingClient *onboarding.Client
+	var ethPrivClient *ethprivate.Client
+	if ethPrivateKey != nil {
+		onboardingClient, err = onboarding.New(host, timeout, chainId, ethPrivateKey)
+		if err != nil {
+			return nil, err
+		}
+		ethPrivClient, err = ethprivate.New(host, timeout, chainId, ethPrivateKey)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	perpetualsAddress, ok := constants.STARKWARE_PERPETUALS_CONTRACT[chainId]
+	if !ok {
+		return nil, fmt.Errorf("no starkware perpetuals contract for chain id %d", chainId)
+	}
+	perpetuals, err := eth.NewStarkwarePerpetuals(common.HexToAddress(perpetualsAddress), ethClient)
+	if err != nil {
+		return nil, err
+	}
+	collateralTokenAddress, ok := constants.TOKEN_CONTRACTS[constants.COLLATERAL_ASSET][chainId]
+	if !ok {
+		return nil, fmt.Errorf("no collateral token contract for chain id %d", chainId)
+	}
+	collateralToken, err := eth.NewERC20(common.HexToAddress(collateralTokenAddress), ethClient)
+	if err != nil {
+		return nil, err
+	}
+
 	return &Client{
-		host:    host,
-		chainId: chainId,
+		host:          host,
+		chainId:       chainId,
+		ethPrivateKey: ethPrivateKey,
+		starkSigner:   starkSigner,
+
+		starkPublicKey:            starkPublicKey,
+		starkPublicKeyYCoordinate: starkPublicKeyYCoordinate,
 
-		ethClient:  ethClient,
-		pubClient:  pubClient,
-		privClient: privClient,
+		ethClient:        ethClient,
+		pubClient:        pubClient,
+		privClient:       privClient,
+		onboardingClient: onboardingClient,
+		ethPrivClient:    ethPrivClient,
+
+		perpetuals:      perpetuals,
+		collateralToken: collateralToken,
 	}, nil
 }
+
+// Public returns the client for the public endpoints.
+func (c Client) Public() *public.Client {
+	return c.pubClient
+}
+
+// Private returns the client for the endpoints authenticated with an
+// API key.
+func (c Client) Private() *private.Client {
+	return c.privClient
+}
+
+// Onboarding returns the client for onboarding new users. It is nil if
+// the Client was initialized without an Ethereum private key.
+func (c Client) Onboarding() *onboarding.Client {
+	return c.onboardingClient
+}
+
+// EthPrivate returns the client for the endpoints authenticated with
+// the Ethereum key, such as API key management. It is nil if the Client
+// was initialized without an Ethereum private key.
+func (c Client) EthPrivate() *ethprivate.Client {
+	return c.ethPrivClient
+}
+
+// Perpetuals returns the bindings of the StarkWare perpetuals contract.
+func (c Client) Perpetuals() *eth.StarkwarePerpetuals {
+	return c.perpetuals
+}
+
+// CollateralToken returns the bindings of the ERC-20 contract of the
+// collateral asset.
+func (c Client) CollateralToken() *eth.ERC20 {
+	return c.collateralToken
+}
+
+// DeriveStarkKey derives the STARK key pair that the dYdX frontend would
+// use for the Ethereum key the Client was initialized with.
+func (c Client) DeriveStarkKey() (*starkex.KeyPair, error) {
+	if c.ethPrivateKey == nil {
+		return nil, errors.New("ethereum private key is required to derive the stark key")
+	}
+	return ethsigning.DeriveStarkKey(c.ethPrivateKey, c.chainId)
+}
+
+// Onboard registers the Ethereum address of the Client as a new dYdX user
+// and returns the credentials of its default API key, which can be passed
+// to New. The STARK key the Client was initialized with is registered for
+// the user; if there is none, the key derived by DeriveStarkKey is used.
+func (c Client) Onboard() (map[string]string, error) {
+	if c.onboardingClient == nil {
+		return nil, errors.New("ethereum private key is required for onboarding")
+	}
+	address := c.onboardingClient.Address()
+	exists, err := c.pubClient.UserExists(address.Hex())
+	if err != nil {
+		return nil, err
+	}
+	if exists {
+		return nil, fmt.Errorf("user %s is already onboarded", address.Hex())
+	}
+
+	req := &types.OnboardingRequest{}
+	if c.starkPublicKey != "" && c.starkPublicKeyYCoordinate != "" {
+		req.StarkKey = c.starkPublicKey
+		req.StarkKeyYCoordinate = c.starkPublicKeyYCoordinate
+	} else {
+		keyPair, err := c.DeriveStarkKey()
+		if err != nil {
+			return nil, err
+		}
+		req.StarkKey = keyPair.PublicKey
+		req.StarkKeyYCoordinate = keyPair.PublicKeyYCoordinate
+	}
+
+	resp, err := c.onboardingClient.CreateUser(req)
+	if err != nil {
+		return nil, err
+	}
+	return resp.ApiKey.Map(), nil
+}
+
+// FastWithdraw withdraws debitAmount of collateral from the account of
+// the Client to toAddress on L1 through the liquidity provider that
+// quotes the highest credit amount. The withdrawal expires at expiration
+// if no liquidity provider completes it.
+func (c Client) FastWithdraw(debitAmount string, toAddress common.Address, expiration string) (*types.Transfer, error) {
+	creditAsset := constants.COLLATERAL_ASSET
+	lps, err := c.pubClient.GetFastWithdrawal(&creditAsset, nil, &debitAmount)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		bestPositionId string
+		best           *types.LiquidityProvider
+		bestCredit     *big.Rat
+	)
+	for positionId := range lps {
+		lp := lps[positionId]
+		if lp.Quote == nil {
+			continue
+		}
+		credit, ok := new(big.Rat).SetString(lp.Quote.CreditAmount)
+		if !ok {
+			continue
+		}
+		if best == nil || credit.Cmp(bestCredit) > 0 {
+			bestPositionId, best, bestCredit = positionId, &lp, credit
+		}
+	}
+	if best == nil {
+		return nil, fmt.Errorf("no liquidity provider can fill a fast withdrawal of %s %s", debitAmount, creditAsset)
+	}
+
+	account, err := c.privClient.GetAccount(nil)
+	if err != nil {
+		return nil, err
+	}
+	return c.privClient.CreateFastWithdrawal(&types.FastWithdrawalRequest{
+		CreditAsset:  best.Quote.CreditAsset,
+		CreditAmount: best.Quote.CreditAmount,
+		DebitAmount:  best.Quote.DebitAmount,
+		ToAddress:    toAddress.Hex(),
+		LpPositionId: bestPositionId,
+		LpStarkKey:   best.StarkKey,
+		Expiration:   expiration,
+	}, account.PositionId)
+}
@@ -0,0 +1,142 @@
+package client
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/tselementes/dydx-v3-go/constants"
+	"github.com/tselementes/dydx-v3-go/private"
+	"github.com/tselementes/dydx-v3-go/starkex"
+	"github.com/tselementes/dydx-v3-go/types"
+)
+
+const mockStarkPrivateKey = "0x58c7d5a90b1776bde86ebac077e053ed85b0f7164f53b080304a531947f46e3"
+
+func TestFastWithdrawPicksHighestCredit(t *testing.T) {
+	const (
+		bestStarkKey  = "0x05135ef87716b0faecec3ba672d145a6daad0aa46437c365d490022115aba674"
+		otherStarkKey = "0x3b865a18323b8d147a12c556bfb1d502516c325b1477a23ba6c77af31f020fd"
+	)
+	address := common.HexToAddress("0x1234567890123456789012345678901234567890")
+	lps := map[string]types.LiquidityProvider{
+		"1": {StarkKey: otherStarkKey},
+		"2": {StarkKey: otherStarkKey, Quote: &types.LiquidityProviderQuote{
+			CreditAsset: constants.COLLATERAL_ASSET, CreditAmount: "not a number", DebitAmount: "100",
+		}},
+		"3": {StarkKey: otherStarkKey, Quote: &types.LiquidityProviderQuote{
+			CreditAsset: constants.COLLATERAL_ASSET, CreditAmount: "98.5", DebitAmount: "100",
+		}},
+		"4": {StarkKey: bestStarkKey, Quote: &types.LiquidityProviderQuote{
+			CreditAsset: constants.COLLATERAL_ASSET, CreditAmount: "99.25", DebitAmount: "100",
+		}},
+	}
+
+	var posted *types.FastWithdrawalRequest
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/v3/fast-withdrawals":
+			if got := r.URL.Query().Get("debitAmount"); got != "100" {
+				t.Errorf("debitAmount = %q, want 100", got)
+			}
+			json.NewEncoder(w).Encode(map[string]interface{}{"liquidityProviders": lps})
+		case r.Method == http.MethodGet && r.URL.Path == fmt.Sprintf("/v3/accounts/%x", address):
+			json.NewEncoder(w).Encode(types.GetAccountResponse{Account: &types.Account{PositionId: "12345"}})
+		case r.Method == http.MethodPost && r.URL.Path == "/v3/fast-withdrawals":
+			posted = &types.FastWithdrawalRequest{}
+			if err := json.NewDecoder(r.Body).Decode(posted); err != nil {
+				t.Error(err)
+			}
+			json.NewEncoder(w).Encode(types.WithdrawalResponse{Withdrawal: &types.Transfer{}})
+		default:
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	c, err := New(
+		server.URL,
+		time.Second,
+		address,
+		nil,
+		constants.NETWORK_ID_ROPSTEN,
+		"",
+		mockStarkPrivateKey,
+		"",
+		server.URL,
+		map[string]string{private.Key: "key", private.Passphrase: "passphrase", private.Secret: "c2VjcmV0"},
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := c.FastWithdraw("100", address, "2020-09-17T04:15:55.028Z"); err != nil {
+		t.Fatal(err)
+	}
+	if posted == nil {
+		t.Fatal("fast withdrawal was not posted")
+	}
+	if posted.LpPositionId != "4" {
+		t.Errorf("lpPositionId = %q, want 4", posted.LpPositionId)
+	}
+	if posted.CreditAmount != "99.25" {
+		t.Errorf("creditAmount = %q, want 99.25", posted.CreditAmount)
+	}
+
+	// The stark key of the liquidity provider is not sent, but it is part
+	// of the signed message.
+	signer, err := starkex.NewSigner("", mockStarkPrivateKey, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	posted.LpStarkKey = bestStarkKey
+	ok, err := starkex.VerifyFastWithdrawal(constants.NETWORK_ID_ROPSTEN, "12345", posted, signer.PublicKey(), signer.PublicKeyYCoordinate())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !ok {
+		t.Error("fast withdrawal is not signed for the stark key of the liquidity provider")
+	}
+}
+
+func TestFastWithdrawWithoutQuotes(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet || r.URL.Path != "/v3/fast-withdrawals" {
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL)
+		}
+		fmt.Fprint(w, `{"liquidityProviders":{"1":{"starkKey":"0x1","quote":null}}}`)
+	}))
+	defer server.Close()
+
+	c, err := New(server.URL, time.Second, common.Address{}, nil, constants.NETWORK_ID_ROPSTEN, "", mockStarkPrivateKey, "", server.URL, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := c.FastWithdraw("100", common.Address{}, "2020-09-17T04:15:55.028Z"); err == nil {
+		t.Error("expected an error when no liquidity provider quotes")
+	}
+}
+
+func TestNewKeepsStarkPublicKeyWithoutPrivateKey(t *testing.T) {
+	signer, err := starkex.NewSigner("", mockStarkPrivateKey, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	publicKey, publicKeyYCoordinate := signer.PublicKey(), signer.PublicKeyYCoordinate()
+
+	for _, privateKey := range []string{"", mockStarkPrivateKey} {
+		c, err := New("http://localhost", time.Second, common.Address{}, nil, constants.NETWORK_ID_ROPSTEN, publicKey, privateKey, publicKeyYCoordinate, "http://localhost", nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if c.starkPublicKey != publicKey || c.starkPublicKeyYCoordinate != publicKeyYCoordinate {
+			t.Errorf("private key %q: stark public key = %s, %s, want %s, %s", privateKey, c.starkPublicKey, c.starkPublicKeyYCoordinate, publicKey, publicKeyYCoordinate)
+		}
+	}
+}
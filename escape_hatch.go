@@ -0,0 +1,242 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/tselementes/dydx-v3-go/constants"
+)
+
+// EscapeHatch gets funds out of dYdX through the StarkWare perpetuals
+// contract only, for when the dYdX API is not available. Forced requests
+// must be served by dYdX within the grace period of the contract. If
+// they are not, the exchange can be frozen with a freeze request, after
+// which positions can be escaped directly on L1.
+//
+// Since none of its methods use the dYdX API, position ids and amounts
+// have to be provided by the caller.
+type EscapeHatch struct {
+	c Client
+}
+
+// EscapeHatch returns the escape hatch of the Client. Sending any of its
+// transactions requires the Ethereum key and STARK public key of the
+// Client.
+func (c Client) EscapeHatch() *EscapeHatch {
+	return &EscapeHatch{c: c}
+}
+
+// ForcedRequestStatus is the status of a forced request in the StarkWare
+// perpetuals contract.
+type ForcedRequestStatus struct {
+	// Whether the request is pending. A request stops being pending once
+	// it is served by dYdX.
+	Pending bool
+	// When the request was submitted, if it is pending.
+	RequestedAt time.Time
+}
+
+func newForcedRequestStatus(requestTime *big.Int) *ForcedRequestStatus {
+	if requestTime.Sign() == 0 {
+		return &ForcedRequestStatus{}
+	}
+	return &ForcedRequestStatus{
+		Pending:     true,
+		RequestedAt: time.Unix(requestTime.Int64(), 0).UTC(),
+	}
+}
+
+// ForcedTrade is a trade between two positions that is forced through
+// the StarkWare perpetuals contract. Party B has to sign the trade.
+type ForcedTrade struct {
+	StarkKeyA          *big.Int
+	StarkKeyB          *big.Int
+	PositionIdA        *big.Int
+	PositionIdB        *big.Int
+	CollateralAssetId  *big.Int
+	SyntheticAssetId   *big.Int
+	AmountCollateral   *big.Int
+	AmountSynthetic    *big.Int
+	AIsBuyingSynthetic bool
+	// Time after which the request can no longer be submitted, in
+	// hours since the epoch.
+	SubmissionExpirationTime *big.Int
+	Nonce                    *big.Int
+	// Signature of the trade by party B.
+	Signature []byte
+}
+
+// IsFrozen reports whether the exchange has been frozen, in which case
+// positions can only be escaped.
+func (e EscapeHatch) IsFrozen() (bool, error) {
+	frozen, err := e.c.perpetuals.IsFrozen(&bind.CallOpts{Context: context.Background()})
+	if err != nil {
+		return false, fmt.Errorf("cannot check whether the exchange is frozen: %w", err)
+	}
+	return frozen, nil
+}
+
+// ForcedWithdrawalRequest requests amount of collateral to be withdrawn
+// from the position with id positionId and returns the hash of the
+// transaction. premiumCost must be set if the request is submitted while
+// there are other pending forced requests, to pay for serving them.
+func (e EscapeHatch) ForcedWithdrawalRequest(positionId, amount string, premiumCost bool) (common.Hash, error) {
+	starkKey, vaultId, quantizedAmount, err := e.withdrawalArgs(positionId, amount)
+	if err != nil {
+		return common.Hash{}, err
+	}
+	return e.send("forced withdrawal request", func(opts *bind.TransactOpts) (*ethtypes.Transaction, error) {
+		return e.c.perpetuals.ForcedWithdrawalRequest(opts, starkKey, vaultId, quantizedAmount, premiumCost)
+	})
+}
+
+// GetForcedWithdrawalRequest returns the status of the forced withdrawal
+// request of amount from the position with id positionId.
+func (e EscapeHatch) GetForcedWithdrawalRequest(positionId, amount string) (*ForcedRequestStatus, error) {
+	starkKey, vaultId, quantizedAmount, err := e.withdrawalArgs(positionId, amount)
+	if err != nil {
+		return nil, err
+	}
+	requestTime, err := e.c.perpetuals.GetForcedWithdrawalRequest(&bind.CallOpts{Context: context.Background()}, starkKey, vaultId, quantizedAmount)
+	if err != nil {
+		return nil, fmt.Errorf("cannot get forced withdrawal request: %w", err)
+	}
+	return newForcedRequestStatus(requestTime), nil
+}
+
+// FreezeWithdrawalRequest freezes the exchange because the forced
+// withdrawal request of amount from the position with id positionId has
+// not been served within the grace period, and returns the hash of the
+// transaction.
+func (e EscapeHatch) FreezeWithdrawalRequest(positionId, amount string) (common.Hash, error) {
+	starkKey, vaultId, quantizedAmount, err := e.withdrawalArgs(positionId, amount)
+	if err != nil {
+		return common.Hash{}, err
+	}
+	return e.send("freeze request", func(opts *bind.TransactOpts) (*ethtypes.Transaction, error) {
+		return e.c.perpetuals.FreezeRequest0(opts, starkKey, vaultId, quantizedAmount)
+	})
+}
+
+// ForcedTradeRequest requests trade to be executed and returns the hash
+// of the transaction. premiumCost must be set if the request is
+// submitted while there are other pending forced requests, to pay for
+// serving them.
+func (e EscapeHatch) ForcedTradeRequest(trade *ForcedTrade, premiumCost bool) (common.Hash, error) {
+	return e.send("forced trade request", func(opts *bind.TransactOpts) (*ethtypes.Transaction, error) {
+		return e.c.perpetuals.ForcedTradeRequest(
+			opts,
+			trade.StarkKeyA,
+			trade.StarkKeyB,
+			trade.PositionIdA,
+			trade.PositionIdB,
+			trade.CollateralAssetId,
+			trade.SyntheticAssetId,
+			trade.AmountCollateral,
+			trade.AmountSynthetic,
+			trade.AIsBuyingSynthetic,
+			trade.SubmissionExpirationTime,
+			trade.Nonce,
+			trade.Signature,
+			premiumCost,
+		)
+	})
+}
+
+// GetForcedTradeRequest returns the status of the forced trade request
+// of trade.
+func (e EscapeHatch) GetForcedTradeRequest(trade *ForcedTrade) (*ForcedRequestStatus, error) {
+	requestTime, err := e.c.perpetuals.GetForcedTradeRequest(
+		&bind.CallOpts{Context: context.Background()},
+		trade.StarkKeyA,
+		trade.StarkKeyB,
+		trade.PositionIdA,
+		trade.PositionIdB,
+		trade.CollateralAssetId,
+		trade.SyntheticAssetId,
+		trade.AmountCollateral,
+		trade.AmountSynthetic,
+		trade.AIsBuyingSynthetic,
+		trade.Nonce,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("cannot get forced trade request: %w", err)
+	}
+	return newForcedRequestStatus(requestTime), nil
+}
+
+// FreezeTradeRequest freezes the exchange because the forced trade
+// request of trade has not been served within the grace period, and
+// returns the hash of the transaction.
+func (e EscapeHatch) FreezeTradeRequest(trade *ForcedTrade) (common.Hash, error) {
+	return e.send("freeze request", func(opts *bind.TransactOpts) (*ethtypes.Transaction, error) {
+		return e.c.perpetuals.FreezeRequest(
+			opts,
+			trade.StarkKeyA,
+			trade.StarkKeyB,
+			trade.PositionIdA,
+			trade.PositionIdB,
+			trade.CollateralAssetId,
+			trade.SyntheticAssetId,
+			trade.AmountCollateral,
+			trade.AmountSynthetic,
+			trade.AIsBuyingSynthetic,
+			trade.Nonce,
+		)
+	})
+}
+
+// Escape withdraws the position with id positionId once the exchange is
+// frozen and returns the hash of the transaction. amount must be the
+// collateral value of the position in the last state before the freeze.
+// The withdrawn collateral can then be pulled to L1 with
+// CompleteWithdrawal.
+func (e EscapeHatch) Escape(positionId, amount string) (common.Hash, error) {
+	frozen, err := e.IsFrozen()
+	if err != nil {
+		return common.Hash{}, err
+	}
+	if !frozen {
+		return common.Hash{}, errors.New("positions can only be escaped once the exchange is frozen")
+	}
+	starkKey, vaultId, quantizedAmount, err := e.withdrawalArgs(positionId, amount)
+	if err != nil {
+		return common.Hash{}, err
+	}
+	return e.send("escape", func(opts *bind.TransactOpts) (*ethtypes.Transaction, error) {
+		return e.c.perpetuals.Escape(opts, starkKey, vaultId, quantizedAmount)
+	})
+}
+
+// withdrawalArgs converts the arguments of requests that withdraw amount
+// of collateral from the position with id positionId of the Client.
+func (e EscapeHatch) withdrawalArgs(positionId, amount string) (starkKey, vaultId, quantizedAmount *big.Int, err error) {
+	starkKey, err = e.c.starkKey()
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	vaultId, ok := new(big.Int).SetString(positionId, 10)
+	if !ok {
+		return nil, nil, nil, fmt.Errorf("invalid position id: %s", positionId)
+	}
+	quantizedAmount, err = toTokenUnits(amount, constants.COLLATERAL_TOKEN_DECIMALS)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	return starkKey, vaultId, quantizedAmount, nil
+}
+
+func (e EscapeHatch) send(action string, transact func(*bind.TransactOpts) (*ethtypes.Transaction, error)) (common.Hash, error) {
+	tx, err := e.c.sendTransaction(transact)
+	if err != nil {
+		return common.Hash{}, fmt.Errorf("cannot send %s: %w", action, err)
+	}
+	return tx.Hash(), nil
+}
@@ -0,0 +1,6 @@
+// Package eth contains Go bindings for the StarkWare perpetuals and ERC-20
+// contracts used by dYdX, generated from the ABIs in the abi directory.
+package eth
+
+//go:generate abigen --abi ../abi/starkware-perpetuals.json --pkg eth --type StarkwarePerpetuals --out starkware_perpetuals.go
+//go:generate abigen --abi ../abi/erc20.json --pkg eth --type ERC20 --out erc20.go
@@ -0,0 +1,328 @@
+package client
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/common/math"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/tselementes/dydx-v3-go/constants"
+)
+
+const (
+	// How often RegisterUser checks whether dYdX has seen the registration.
+	registrationPollInterval = 5 * time.Second
+	// How long RegisterUser waits for dYdX to see the registration once
+	// the transaction is mined.
+	registrationTimeout = 5 * time.Minute
+)
+
+// ethAddress returns the Ethereum address of the Client. It must only be
+// called after checking that the Client has an Ethereum private key.
+func (c Client) ethAddress() common.Address {
+	return crypto.PubkeyToAddress(c.ethPrivateKey.PublicKey)
+}
+
+// starkKey returns the STARK public key of the Client. Only the public
+// key is needed, so the STARK private key may be kept elsewhere.
+func (c Client) starkKey() (*big.Int, error) {
+	if c.starkPublicKey == "" {
+		return nil, errors.New("stark public key is required")
+	}
+	return parseUint256Hex("stark public key", c.starkPublicKey)
+}
+
+// collateralAssetID returns the StarkEx asset id of the collateral asset,
+// which is also its asset type since it is not mintable.
+func (c Client) collateralAssetID() (*big.Int, error) {
+	assetID, ok := constants.COLLATERAL_ASSET_ID_BY_NETWORK_ID[c.chainId]
+	if !ok {
+		return nil, fmt.Errorf("no collateral asset for chain id %d", c.chainId)
+	}
+	return common.HexToHash(assetID).Big(), nil
+}
+
+// transactOpts returns the options for sending a transaction signed with
+// the Ethereum key of the Client. The gas price is slightly above the
+// one suggested by the node, or DEFAULT_GAS_PRICE if there is none.
+func (c Client) transactOpts() (*bind.TransactOpts, error) {
+	if c.ethPrivateKey == nil {
+		return nil, errors.New("ethereum private key is required to send transactions")
+	}
+	opts, err := bind.NewKeyedTransactorWithChainID(c.ethPrivateKey, big.NewInt(int64(c.chainId)))
+	if err != nil {
+		return nil, err
+	}
+	opts.Context = context.Background()
+	gasPrice, err := c.ethClient.SuggestGasPrice(opts.Context)
+	if err != nil {
+		gasPrice = big.NewInt(constants.DEFAULT_GAS_PRICE)
+	} else {
+		gasPrice.Add(gasPrice, big.NewInt(constants.DEFAULT_GAS_PRICE_ADDITION))
+	}
+	opts.GasPrice = gasPrice
+	return opts, nil
+}
+
+// sendTransaction sends the transaction built by transact. The gas limit
+// is the estimated gas times DEFAULT_GAS_MULTIPLIER. The transaction is
+// not sent if the gas cannot be estimated, which almost always means that
+// it would revert.
+func (c Client) sendTransaction(transact func(*bind.TransactOpts) (*ethtypes.Transaction, error)) (*ethtypes.Transaction, error) {
+	opts, err := c.transactOpts()
+	if err != nil {
+		return nil, err
+	}
+
+	opts.NoSend = true
+	tx, err := transact(opts)
+	if err != nil {
+		return nil, fmt.Errorf("cannot estimate gas: %w", err)
+	}
+	opts.GasLimit = uint64(float64(tx.Gas()) * constants.DEFAULT_GAS_MULTIPLIER)
+
+	opts.NoSend = false
+	return transact(opts)
+}
+
+// Deposit deposits amount of collateral from the Ethereum address of the
+// Client to its position on L2 and returns the hash of the deposit
+// transaction. If the allowance of the StarkWare perpetuals contract is
+// not enough for amount, it is increased first, to the maximum possible
+// allowance if approveMax is set, and the deposit is only sent once the
+// approval is mined.
+func (c Client) Deposit(amount string, approveMax bool) (common.Hash, error) {
+	if c.ethPrivateKey == nil {
+		return common.Hash{}, errors.New("ethereum private key is required to deposit")
+	}
+	starkKey, err := c.starkKey()
+	if err != nil {
+		return common.Hash{}, err
+	}
+	assetType, err := c.collateralAssetID()
+	if err != nil {
+		return common.Hash{}, err
+	}
+	quantizedAmount, err := toTokenUnits(amount, constants.COLLATERAL_TOKEN_DECIMALS)
+	if err != nil {
+		return common.Hash{}, err
+	}
+	account, err := c.privClient.GetAccount(nil)
+	if err != nil {
+		return common.Hash{}, err
+	}
+	positionId, ok := new(big.Int).SetString(account.PositionId, 10)
+	if !ok {
+		return common.Hash{}, fmt.Errorf("invalid position id: %s", account.PositionId)
+	}
+
+	allowance := quantizedAmount
+	if approveMax {
+		allowance = math.MaxBig256
+	}
+	if err := c.ensureCollateralAllowance(quantizedAmount, allowance); err != nil {
+		return common.Hash{}, err
+	}
+
+	tx, err := c.sendTransaction(func(opts *bind.TransactOpts) (*ethtypes.Transaction, error) {
+		return c.perpetuals.Deposit0(opts, starkKey, assetType, positionId, quantizedAmount)
+	})
+	if err != nil {
+		return common.Hash{}, fmt.Errorf("cannot deposit: %w", err)
+	}
+	return tx.Hash(), nil
+}
+
+// ensureCollateralAllowance approves the StarkWare perpetuals contract to
+// spend allowance of the collateral token of the Client, unless it can
+// already spend at least amount, and waits until the approval is mined.
+func (c Client) ensureCollateralAllowance(amount, allowance *big.Int) error {
+	owner := c.ethAddress()
+	spender := common.HexToAddress(constants.STARKWARE_PERPETUALS_CONTRACT[c.chainId])
+	current, err := c.collateralToken.Allowance(&bind.CallOpts{Context: context.Background()}, owner, spender)
+	if err != nil {
+		return fmt.Errorf("cannot get allowance: %w", err)
+	}
+	if current.Cmp(amount) >= 0 {
+		return nil
+	}
+	tx, err := c.sendTransaction(func(opts *bind.TransactOpts) (*ethtypes.Transaction, error) {
+		return c.collateralToken.Approve(opts, spender, allowance)
+	})
+	if err != nil {
+		return fmt.Errorf("cannot approve allowance: %w", err)
+	}
+	// The deposit reverts unless the approval is mined first.
+	receipt, err := bind.WaitMined(context.Background(), c.ethClient, tx)
+	if err != nil {
+		return err
+	}
+	if receipt.Status != ethtypes.ReceiptStatusSuccessful {
+		return fmt.Errorf("approval transaction %s failed", tx.Hash().Hex())
+	}
+	return nil
+}
+
+// parseUint256Hex parses a hex-encoded 256-bit unsigned integer with an
+// optional 0x prefix.
+func parseUint256Hex(name, s string) (*big.Int, error) {
+	digits := strings.TrimPrefix(strings.TrimPrefix(s, "0x"), "0X")
+	if len(digits)%2 == 1 {
+		digits = "0" + digits
+	}
+	b, err := hex.DecodeString(digits)
+	if err != nil || len(b) == 0 {
+		return nil, fmt.Errorf("invalid %s: %q", name, s)
+	}
+	v := new(big.Int).SetBytes(b)
+	if v.BitLen() > 256 {
+		return nil, fmt.Errorf("%s does not fit in 256 bits: %s", name, s)
+	}
+	return v, nil
+}
+
+// fromTokenUnits converts an amount of base units of a token with the
+// given number of decimals into a human readable amount.
+func fromTokenUnits(amount *big.Int, decimals int) string {
+	r := new(big.Rat).SetFrac(amount, new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(decimals)), nil))
+	human := r.FloatString(decimals)
+	if strings.Contains(human, ".") {
+		human = strings.TrimRight(strings.TrimRight(human, "0"), ".")
+	}
+	return human
+}
+
+// toTokenUnits converts a human readable amount of a token with the given
+// number of decimals into its base units.
+func toTokenUnits(humanAmount string, decimals int) (*big.Int, error) {
+	amount, ok := new(big.Rat).SetString(humanAmount)
+	if !ok {
+		return nil, fmt.Errorf("invalid amount: %q", humanAmount)
+	}
+	amount.Mul(amount, new(big.Rat).SetInt(new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(decimals)), nil)))
+	if amount.Sign() <= 0 {
+		return nil, fmt.Errorf("amount must be positive: %s", humanAmount)
+	}
+	if !amount.IsInt() {
+		return nil, fmt.Errorf("amount %s has more than %d decimals", humanAmount, decimals)
+	}
+	return amount.Num(), nil
+}
+
+// RegisterUser registers the Ethereum address and STARK key of the Client
+// in the StarkWare perpetuals contract, which is required to withdraw
+// funds on L1. It waits until the transaction is mined and dYdX reports
+// the user as registered, and returns the hash of the transaction.
+func (c Client) RegisterUser() (common.Hash, error) {
+	if c.ethPrivateKey == nil {
+		return common.Hash{}, errors.New("ethereum private key is required to register")
+	}
+	starkKey, err := c.starkKey()
+	if err != nil {
+		return common.Hash{}, err
+	}
+	registration, err := c.privClient.GetRegistration()
+	if err != nil {
+		return common.Hash{}, err
+	}
+	signature, err := hexutil.Decode(registration.Signature)
+	if err != nil {
+		return common.Hash{}, fmt.Errorf("invalid registration signature: %w", err)
+	}
+	tx, err := c.sendTransaction(func(opts *bind.TransactOpts) (*ethtypes.Transaction, error) {
+		return c.perpetuals.RegisterUser(opts, c.ethAddress(), starkKey, signature)
+	})
+	if err != nil {
+		return common.Hash{}, fmt.Errorf("cannot register user: %w", err)
+	}
+	receipt, err := bind.WaitMined(context.Background(), c.ethClient, tx)
+	if err != nil {
+		return tx.Hash(), err
+	}
+	if receipt.Status != ethtypes.ReceiptStatusSuccessful {
+		return tx.Hash(), fmt.Errorf("registration transaction %s failed", tx.Hash().Hex())
+	}
+
+	deadline := time.Now().Add(registrationTimeout)
+	for {
+		user, err := c.privClient.GetUser()
+		if err != nil {
+			return tx.Hash(), err
+		}
+		if user.IsRegistered {
+			return tx.Hash(), nil
+		}
+		if time.Now().After(deadline) {
+			return tx.Hash(), fmt.Errorf("user is still not registered %v after transaction %s was mined", registrationTimeout, tx.Hash().Hex())
+		}
+		time.Sleep(registrationPollInterval)
+	}
+}
+
+// GetWithdrawalBalance returns the human readable amount of assetId that
+// starkKey can withdraw on L1 with CompleteWithdrawal. starkKey defaults
+// to the STARK key of the Client and assetId to the collateral asset,
+// which is the only asset that can be withdrawn from dYdX. starkKey and
+// assetId must be hex-encoded 256-bit integers.
+func (c Client) GetWithdrawalBalance(starkKey, assetId *string) (string, error) {
+	var key, asset *big.Int
+	var err error
+	if starkKey != nil {
+		key, err = parseUint256Hex("stark key", *starkKey)
+	} else {
+		key, err = c.starkKey()
+	}
+	if err != nil {
+		return "", err
+	}
+	if assetId != nil {
+		asset, err = parseUint256Hex("asset id", *assetId)
+	} else {
+		asset, err = c.collateralAssetID()
+	}
+	if err != nil {
+		return "", err
+	}
+
+	balance, err := c.perpetuals.GetWithdrawalBalance(&bind.CallOpts{Context: context.Background()}, key, asset)
+	if err != nil {
+		return "", fmt.Errorf("cannot get withdrawal balance: %w", err)
+	}
+	// The quantum of the collateral asset is a single base unit
+	// of the token, so the balance is in base units.
+	return fromTokenUnits(balance, constants.COLLATERAL_TOKEN_DECIMALS), nil
+}
+
+// CompleteWithdrawal withdraws the collateral that has been withdrawn
+// from L2 to the Ethereum address of the Client and returns the hash of
+// the transaction.
+func (c Client) CompleteWithdrawal() (common.Hash, error) {
+	if c.ethPrivateKey == nil {
+		return common.Hash{}, errors.New("ethereum private key is required to withdraw")
+	}
+	starkKey, err := c.starkKey()
+	if err != nil {
+		return common.Hash{}, err
+	}
+	assetType, err := c.collateralAssetID()
+	if err != nil {
+		return common.Hash{}, err
+	}
+	tx, err := c.sendTransaction(func(opts *bind.TransactOpts) (*ethtypes.Transaction, error) {
+		return c.perpetuals.Withdraw(opts, starkKey, assetType)
+	})
+	if err != nil {
+		return common.Hash{}, fmt.Errorf("cannot complete withdrawal: %w", err)
+	}
+	return tx.Hash(), nil
+}
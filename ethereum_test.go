@@ -0,0 +1,65 @@
+package client
+
+import (
+	"math/big"
+	"strings"
+	"testing"
+)
+
+func TestParseUint256Hex(t *testing.T) {
+	max := new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 256), big.NewInt(1))
+	tests := []struct {
+		in   string
+		want *big.Int
+	}{
+		{"0x05135ef87716b0faecec3ba672d145a6daad0aa46437c365d490022115aba674", mustBig("0x5135ef87716b0faecec3ba672d145a6daad0aa46437c365d490022115aba674")},
+		{"0xabc", big.NewInt(0xabc)},
+		{"0XABC", big.NewInt(0xabc)},
+		{"abc", big.NewInt(0xabc)},
+		{"0x0", big.NewInt(0)},
+		{"0x" + strings.Repeat("f", 64), max},
+		{"0x00" + strings.Repeat("f", 64), max},
+		{"", nil},
+		{"0x", nil},
+		{"0xzz", nil},
+		{"-0x1", nil},
+		{"0x 1", nil},
+		{"0x1" + strings.Repeat("0", 64), nil},
+	}
+	for _, tt := range tests {
+		got, err := parseUint256Hex("stark key", tt.in)
+		if tt.want == nil {
+			if err == nil {
+				t.Errorf("parseUint256Hex(%q) = %#x, want an error", tt.in, got)
+			}
+			continue
+		}
+		if err != nil || got.Cmp(tt.want) != 0 {
+			t.Errorf("parseUint256Hex(%q) = %v, %v, want %#x", tt.in, got, err, tt.want)
+		}
+	}
+}
+
+func mustBig(s string) *big.Int {
+	v, ok := new(big.Int).SetString(strings.TrimPrefix(s, "0x"), 16)
+	if !ok {
+		panic("invalid hex: " + s)
+	}
+	return v
+}
+
+func TestStarkKeyWithoutPrivateKey(t *testing.T) {
+	const publicKey = "0x3b865a18323b8d147a12c556bfb1d502516c325b1477a23ba6c77af31f020fd"
+	c := Client{starkPublicKey: publicKey}
+	got, err := c.starkKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := mustBig(publicKey); got.Cmp(want) != 0 {
+		t.Errorf("stark key = %#x, want %#x", got, want)
+	}
+
+	if _, err := (Client{}).starkKey(); err == nil {
+		t.Error("expected an error without a stark public key")
+	}
+}
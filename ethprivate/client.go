@@ -0,0 +1,124 @@
+package ethprivate
+
+import (
+	"crypto/ecdsa"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/tselementes/dydx-v3-go/ethsigning"
+	"github.com/tselementes/dydx-v3-go/types"
+)
+
+// Client calls the endpoints that are authenticated with a signature of
+// the Ethereum key of the user instead of an API key.
+type Client struct {
+	host          string
+	client        *http.Client
+	networkId     int
+	ethPrivateKey *ecdsa.PrivateKey
+}
+
+func New(
+	host string,
+	timeout time.Duration,
+	networkId int,
+	ethPrivateKey *ecdsa.PrivateKey,
+) (*Client, error) {
+	_, err := url.Parse(host)
+	if err != nil {
+		return nil, err
+	}
+	if ethPrivateKey == nil {
+		return nil, fmt.Errorf("ethereum private key is required for signing")
+	}
+	return &Client{
+		host: host,
+		client: &http.Client{
+			Timeout: timeout,
+		},
+		networkId:     networkId,
+		ethPrivateKey: ethPrivateKey,
+	}, nil
+}
+
+// Address returns the Ethereum address the Client signs requests for.
+func (c Client) Address() common.Address {
+	return crypto.PubkeyToAddress(c.ethPrivateKey.PublicKey)
+}
+
+func (c Client) doRequest(method, path string, urlParams map[string]string) ([]byte, error) {
+	host, err := url.Parse(c.host)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse host (%s): %w", c.host, err)
+	}
+	host.Path = "/v3/" + path
+
+	if len(urlParams) > 0 {
+		q := host.Query()
+		for k, v := range urlParams {
+			q.Set(k, v)
+		}
+		host.RawQuery = q.Encode()
+	}
+
+	req, err := http.NewRequest(method, host.String(), nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build new request: %w", err)
+	}
+
+	// None of the endpoints take a body, which is signed as an
+	// empty JSON object.
+	now := time.Now().UTC().Format(time.RFC3339)
+	signature, err := ethsigning.SignApiKeyAction(c.ethPrivateKey, c.networkId, method, host.RequestURI(), "{}", now)
+	if err != nil {
+		return nil, fmt.Errorf("failed to sign request: %w", err)
+	}
+
+	req.Header.Set("DYDX-SIGNATURE", signature)
+	req.Header.Set("DYDX-TIMESTAMP", now)
+	req.Header.Set("DYDX-ETHEREUM-ADDRESS", c.Address().Hex())
+
+	// execute the request
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to %s %s: %w", method, host.Path, err)
+	}
+	defer resp.Body.Close()
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return nil, fmt.Errorf("unexpected response status: %v: %s", resp.Status, body)
+	}
+	return body, nil
+}
+
+// CreateApiKey creates a new API key for the user. The returned
+// credentials can be passed to private.New via their Map method.
+func (c Client) CreateApiKey() (*types.ApiKeyCredentials, error) {
+	data, err := c.doRequest(http.MethodPost, "api-keys", nil)
+	if err != nil {
+		return nil, err
+	}
+	resp := &types.CreateApiKeyResponse{}
+	if err := json.Unmarshal(data, resp); err != nil {
+		return nil, err
+	}
+	return resp.ApiKey, nil
+}
+
+// DeleteApiKey deletes the API key apiKey of the user.
+func (c Client) DeleteApiKey(apiKey string) error {
+	_, err := c.doRequest(http.MethodDelete, "api-keys", map[string]string{
+		"apiKey": apiKey,
+	})
+	return err
+}
@@ -0,0 +1,96 @@
+package ethprivate
+
+import (
+	"crypto/ecdsa"
+	"encoding/hex"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/tselementes/dydx-v3-go/constants"
+	"github.com/tselementes/dydx-v3-go/ethsigning"
+)
+
+func ganacheKey(t *testing.T) *ecdsa.PrivateKey {
+	t.Helper()
+	key, err := crypto.HexToECDSA("4f3edf983ac636a65a842ce7c78d9aa706d3b113bce9c46f30d7d21715b23b1d")
+	if err != nil {
+		t.Fatal(err)
+	}
+	return key
+}
+
+// recoverSigner returns the address that made the typed signature of hash.
+func recoverSigner(t *testing.T, hash []byte, signature string) common.Address {
+	t.Helper()
+	sig, err := hex.DecodeString(strings.TrimPrefix(signature, "0x"))
+	if err != nil || len(sig) != 66 {
+		t.Fatalf("malformed typed signature: %q", signature)
+	}
+	sig = sig[:65]
+	sig[crypto.RecoveryIDOffset] -= 27
+	pub, err := crypto.SigToPub(hash, sig)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return crypto.PubkeyToAddress(*pub)
+}
+
+// apiKeyServer checks that requests are signed by address for the given
+// method and request URI, with an empty JSON object as body.
+func apiKeyServer(t *testing.T, address common.Address, method, requestURI, response string) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != method || r.URL.RequestURI() != requestURI {
+			t.Errorf("request = %s %s, want %s %s", r.Method, r.URL.RequestURI(), method, requestURI)
+		}
+		if got := r.Header.Get("DYDX-ETHEREUM-ADDRESS"); got != address.Hex() {
+			t.Errorf("DYDX-ETHEREUM-ADDRESS = %q, want %q", got, address.Hex())
+		}
+		timestamp := r.Header.Get("DYDX-TIMESTAMP")
+		if _, err := time.Parse(time.RFC3339, timestamp); err != nil {
+			t.Errorf("invalid DYDX-TIMESTAMP: %v", err)
+		}
+		hash := ethsigning.ApiKeyActionHash(constants.NETWORK_ID_ROPSTEN, method, requestURI, "{}", timestamp)
+		if got := recoverSigner(t, hash, r.Header.Get("DYDX-SIGNATURE")); got != address {
+			t.Errorf("DYDX-SIGNATURE is signed by %s, want %s", got.Hex(), address.Hex())
+		}
+		w.Write([]byte(response))
+	}))
+}
+
+func TestCreateApiKey(t *testing.T) {
+	key := ganacheKey(t)
+	server := apiKeyServer(t, crypto.PubkeyToAddress(key.PublicKey), http.MethodPost, "/v3/api-keys", `{"apiKey":{"key":"k","secret":"s","passphrase":"p"}}`)
+	defer server.Close()
+
+	c, err := New(server.URL, time.Second, constants.NETWORK_ID_ROPSTEN, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	creds, err := c.CreateApiKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if creds.Key != "k" || creds.Secret != "s" || creds.Passphrase != "p" {
+		t.Errorf("credentials = %+v", creds)
+	}
+}
+
+func TestDeleteApiKey(t *testing.T) {
+	key := ganacheKey(t)
+	server := apiKeyServer(t, crypto.PubkeyToAddress(key.PublicKey), http.MethodDelete, "/v3/api-keys?apiKey=50fdcaa0-62b8-e827-02e8-a9520d46cb9f", `{}`)
+	defer server.Close()
+
+	c, err := New(server.URL, time.Second, constants.NETWORK_ID_ROPSTEN, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := c.DeleteApiKey("50fdcaa0-62b8-e827-02e8-a9520d46cb9f"); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,28 @@
+package ethsigning
+
+import (
+	"crypto/ecdsa"
+
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+const eip712ApiKeyActionStructString = "dYdX(string method,string requestPath,string body,string timestamp)"
+
+// ApiKeyActionHash returns the EIP-712 hash of a request to one of the
+// API key management endpoints.
+func ApiKeyActionHash(networkId int, method, requestPath, body, timestamp string) []byte {
+	structHash := crypto.Keccak256(
+		hashString(eip712ApiKeyActionStructString),
+		hashString(method),
+		hashString(requestPath),
+		hashString(body),
+		hashString(timestamp),
+	)
+	return eip712Hash(networkId, structHash)
+}
+
+// SignApiKeyAction signs a request to one of the API key management
+// endpoints with key and returns the typed signature.
+func SignApiKeyAction(key *ecdsa.PrivateKey, networkId int, method, requestPath, body, timestamp string) (string, error) {
+	return signHash(key, ApiKeyActionHash(networkId, method, requestPath, body, timestamp))
+}
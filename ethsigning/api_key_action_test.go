@@ -0,0 +1,21 @@
+package ethsigning
+
+import (
+	"testing"
+
+	"github.com/tselementes/dydx-v3-go/constants"
+)
+
+func TestSignApiKeyAction(t *testing.T) {
+	// EXPECTED_SIGNATURE of the Ethereum private action tests of the dydx3
+	// Python client.
+	const want = "0x3ec5317783b313b0acac1f13a23eaaa2fca1f45c2f395081e9bfc20b4cc1acb17e3d755764f37bf13fa62565c9cb50475e0a987ab0afa74efde0b3926bb7ab9d1b00"
+
+	got, err := SignApiKeyAction(ganacheKey(t), constants.NETWORK_ID_MAINNET, "POST", "v3/test", "{}", "2021-01-08T10:06:12.500Z")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got != want {
+		t.Errorf("signature = %s, want %s", got, want)
+	}
+}
@@ -0,0 +1,41 @@
+package ethsigning
+
+import (
+	"crypto/ecdsa"
+
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/tselementes/dydx-v3-go/constants"
+)
+
+const (
+	eip712OnboardingActionStructString        = "dYdX(string action,string onlySignOn)"
+	eip712OnboardingActionStructStringTestnet = "dYdX(string action)"
+	onlySignOnDomainMainnet                   = "https://trade.dydx.exchange"
+)
+
+// OnboardingActionHash returns the EIP-712 hash of an onboarding action
+// such as constants.OFF_CHAIN_ONBOARDING_ACTION. On mainnet, the action is
+// additionally bound to the dYdX trading domain.
+func OnboardingActionHash(networkId int, action string) []byte {
+	var structHash []byte
+	if networkId == constants.NETWORK_ID_MAINNET {
+		structHash = crypto.Keccak256(
+			hashString(eip712OnboardingActionStructString),
+			hashString(action),
+			hashString(onlySignOnDomainMainnet),
+		)
+	} else {
+		structHash = crypto.Keccak256(
+			hashString(eip712OnboardingActionStructStringTestnet),
+			hashString(action),
+		)
+	}
+	return eip712Hash(networkId, structHash)
+}
+
+// SignOnboardingAction signs an onboarding action with key and returns the
+// typed signature.
+func SignOnboardingAction(key *ecdsa.PrivateKey, networkId int, action string) (string, error) {
+	return signHash(key, OnboardingActionHash(networkId, action))
+}
@@ -0,0 +1,65 @@
+package ethsigning
+
+import (
+	"crypto/ecdsa"
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/tselementes/dydx-v3-go/constants"
+)
+
+const (
+	eip712Domain                 = "dYdX"
+	eip712Version                = "1.0"
+	eip712DomainStringNoContract = "EIP712Domain(string name,string version,uint256 chainId)"
+	eip712StructName             = "dYdX"
+)
+
+func hashString(s string) []byte {
+	return crypto.Keccak256([]byte(s))
+}
+
+func domainHash(networkId int) []byte {
+	return crypto.Keccak256(
+		hashString(eip712DomainStringNoContract),
+		hashString(eip712Domain),
+		hashString(eip712Version),
+		common.LeftPadBytes(big.NewInt(int64(networkId)).Bytes(), 32),
+	)
+}
+
+// eip712Hash returns the EIP-712 hash of a dYdX struct on networkId.
+func eip712Hash(networkId int, structHash []byte) []byte {
+	return crypto.Keccak256(
+		[]byte{0x19, 0x01},
+		domainHash(networkId),
+		structHash,
+	)
+}
+
+// signHash signs hash with key and returns a typed signature, i.e. the
+// hex-encoded r, s and v followed by the signature type.
+func signHash(key *ecdsa.PrivateKey, hash []byte) (string, error) {
+	sig, err := crypto.Sign(hash, key)
+	if err != nil {
+		return "", fmt.Errorf("cannot sign hash: %w", err)
+	}
+	// go-ethereum returns a recovery id of 0 or 1 while
+	// the dYdX API expects v to be 27 or 28.
+	sig[crypto.RecoveryIDOffset] += 27
+	return fmt.Sprintf("0x%x%02x", sig, constants.SIGNATURE_TYPE_NO_PREPEND), nil
+}
+
+// decodeSignature decodes a typed signature into its raw bytes.
+func decodeSignature(signature string) ([]byte, error) {
+	b, err := hex.DecodeString(strings.TrimPrefix(signature, "0x"))
+	if err != nil {
+		return nil, fmt.Errorf("cannot decode signature: %w", err)
+	}
+	return b, nil
+}
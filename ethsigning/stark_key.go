@@ -0,0 +1,30 @@
+package ethsigning
+
+import (
+	"crypto/ecdsa"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/tselementes/dydx-v3-go/constants"
+	"github.com/tselementes/dydx-v3-go/starkex"
+)
+
+// DeriveStarkKey derives the STARK key pair that the dYdX frontend
+// associates with key on networkId. The key derivation action is signed
+// with key and the STARK private key is taken from the hash of the
+// signature.
+func DeriveStarkKey(key *ecdsa.PrivateKey, networkId int) (*starkex.KeyPair, error) {
+	signature, err := SignOnboardingAction(key, networkId, constants.OFF_CHAIN_KEY_DERIVATION_ACTION)
+	if err != nil {
+		return nil, err
+	}
+	sig, err := decodeSignature(signature)
+	if err != nil {
+		return nil, err
+	}
+	privateKey := new(big.Int).SetBytes(crypto.Keccak256(sig))
+	// Drop the last five bits so the key fits in the STARK field.
+	privateKey.Rsh(privateKey, 5)
+	return starkex.NewKeyPair(privateKey)
+}
@@ -0,0 +1,54 @@
+package ethsigning
+
+import (
+	"crypto/ecdsa"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/tselementes/dydx-v3-go/constants"
+)
+
+// ganacheKey returns the key of the first Ganache test account, which the
+// dydx3 Python client uses in its signing tests.
+func ganacheKey(t *testing.T) *ecdsa.PrivateKey {
+	t.Helper()
+	key, err := crypto.HexToECDSA("4f3edf983ac636a65a842ce7c78d9aa706d3b113bce9c46f30d7d21715b23b1d")
+	if err != nil {
+		t.Fatal(err)
+	}
+	return key
+}
+
+func TestDeriveStarkKey(t *testing.T) {
+	// EXPECTED_STARK_KEY_PAIR_* of the onboarding tests of the dydx3
+	// Python client.
+	tests := []struct {
+		networkId            int
+		publicKey            string
+		publicKeyYCoordinate string
+		privateKey           string
+	}{
+		{
+			networkId:            constants.NETWORK_ID_MAINNET,
+			publicKey:            "0x39d88860b99b1809a63add01f7dfa59676ae006bbcdf38ff30b6a69dcf55ed3",
+			publicKeyYCoordinate: "0x2bdd58a2c2acb241070bc5d55659a85bba65211890a8c47019a33902aba8400",
+			privateKey:           "0x170d807cafe3d8b5758f3f698331d292bf5aeb71f6fd282f0831dee094ee891",
+		},
+		{
+			networkId:            constants.NETWORK_ID_ROPSTEN,
+			publicKey:            "0x35e23a936e596969a6b3131cfccbd18b71779f28276d30e8215cd0d3e9252c2",
+			publicKeyYCoordinate: "0x557d1a1be389d9921b9d16415eac12bd276b05e2564c4b30a7730ace13a0e19",
+			privateKey:           "0x50505654b282eb3debadddeddfa1bc76545a6837dcd59d7d41f6a282a4bbccc",
+		},
+	}
+	for _, tt := range tests {
+		got, err := DeriveStarkKey(ganacheKey(t), tt.networkId)
+		if err != nil {
+			t.Fatalf("network %d: %v", tt.networkId, err)
+		}
+		if got.PublicKey != tt.publicKey || got.PublicKeyYCoordinate != tt.publicKeyYCoordinate || got.PrivateKey != tt.privateKey {
+			t.Errorf("network %d: key pair = %+v, want %s, %s, %s", tt.networkId, got, tt.publicKey, tt.publicKeyYCoordinate, tt.privateKey)
+		}
+	}
+}
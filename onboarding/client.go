@@ -0,0 +1,139 @@
+package onboarding
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/tselementes/dydx-v3-go/constants"
+	"github.com/tselementes/dydx-v3-go/ethsigning"
+	"github.com/tselementes/dydx-v3-go/types"
+)
+
+// Client onboards users to dYdX. Requests are authenticated with a
+// signature of the onboarding action made with the Ethereum key of the
+// user, so no API key is required.
+type Client struct {
+	host          string
+	client        *http.Client
+	networkId     int
+	ethPrivateKey *ecdsa.PrivateKey
+}
+
+func New(
+	host string,
+	timeout time.Duration,
+	networkId int,
+	ethPrivateKey *ecdsa.PrivateKey,
+) (*Client, error) {
+	_, err := url.Parse(host)
+	if err != nil {
+		return nil, err
+	}
+	if ethPrivateKey == nil {
+		return nil, fmt.Errorf("ethereum private key is required for onboarding")
+	}
+	return &Client{
+		host: host,
+		client: &http.Client{
+			Timeout: timeout,
+		},
+		networkId:     networkId,
+		ethPrivateKey: ethPrivateKey,
+	}, nil
+}
+
+// Address returns the Ethereum address that is onboarded by the Client.
+func (c Client) Address() common.Address {
+	return crypto.PubkeyToAddress(c.ethPrivateKey.PublicKey)
+}
+
+func (c Client) post(path string, data []byte) (*http.Response, error) {
+	host, err := url.Parse(c.host)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse host (%s): %w", c.host, err)
+	}
+	host.Path = "/v3/" + path
+
+	req, err := http.NewRequest(http.MethodPost, host.String(), bytes.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf("failed to build new request: %w", err)
+	}
+
+	signature, err := ethsigning.SignOnboardingAction(c.ethPrivateKey, c.networkId, constants.OFF_CHAIN_ONBOARDING_ACTION)
+	if err != nil {
+		return nil, fmt.Errorf("failed to sign request: %w", err)
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("DYDX-SIGNATURE", signature)
+	req.Header.Set("DYDX-ETHEREUM-ADDRESS", c.Address().Hex())
+
+	// execute the request
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to POST %s: %w", host.Path, err)
+	}
+	return resp, nil
+}
+
+// CreateUser onboards the Ethereum address of the Client with the STARK
+// public key provided in req. The response includes the credentials of
+// the default API key of the new user.
+func (c Client) CreateUser(req *types.OnboardingRequest) (*types.OnboardingResponse, error) {
+	data, err := json.Marshal(req)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := c.post("onboarding", data)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusCreated && resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected response status: %v: %s", resp.Status, body)
+	}
+	oResp := &types.OnboardingResponse{}
+	if err := json.Unmarshal(body, oResp); err != nil {
+		return nil, err
+	}
+	return oResp, nil
+}
+
+// RecoverDefaultApiKeyCredentials derives the credentials of the default
+// API key that was created when the user was onboarded. This allows
+// recovering API access for users that were onboarded with the same
+// Ethereum key, e.g. through the web UI.
+func (c Client) RecoverDefaultApiKeyCredentials() (*types.ApiKeyCredentials, error) {
+	signature, err := ethsigning.SignOnboardingAction(c.ethPrivateKey, c.networkId, constants.OFF_CHAIN_ONBOARDING_ACTION)
+	if err != nil {
+		return nil, err
+	}
+	sig, err := hex.DecodeString(signature[2:])
+	if err != nil {
+		return nil, fmt.Errorf("cannot decode signature: %w", err)
+	}
+	hashedR := crypto.Keccak256(sig[:32])
+	hashedS := crypto.Keccak256(sig[32:64])
+
+	key := hex.EncodeToString(hashedS[:16])
+	return &types.ApiKeyCredentials{
+		Key:        fmt.Sprintf("%s-%s-%s-%s-%s", key[:8], key[8:12], key[12:16], key[16:20], key[20:]),
+		Secret:     base64.URLEncoding.EncodeToString(hashedR[:30]),
+		Passphrase: base64.URLEncoding.EncodeToString(hashedS[16:31]),
+	}, nil
+}
@@ -0,0 +1,141 @@
+package onboarding
+
+import (
+	"crypto/ecdsa"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/tselementes/dydx-v3-go/constants"
+	"github.com/tselementes/dydx-v3-go/ethsigning"
+	"github.com/tselementes/dydx-v3-go/types"
+)
+
+// ganacheKey returns the key of the first Ganache test account, which the
+// dydx3 Python client uses in its onboarding tests.
+func ganacheKey(t *testing.T) *ecdsa.PrivateKey {
+	t.Helper()
+	key, err := crypto.HexToECDSA("4f3edf983ac636a65a842ce7c78d9aa706d3b113bce9c46f30d7d21715b23b1d")
+	if err != nil {
+		t.Fatal(err)
+	}
+	return key
+}
+
+// recoverSigner returns the address that made the typed signature of hash.
+func recoverSigner(t *testing.T, hash []byte, signature string) common.Address {
+	t.Helper()
+	sig, err := hex.DecodeString(strings.TrimPrefix(signature, "0x"))
+	if err != nil || len(sig) != 66 {
+		t.Fatalf("malformed typed signature: %q", signature)
+	}
+	sig = sig[:65]
+	sig[crypto.RecoveryIDOffset] -= 27
+	pub, err := crypto.SigToPub(hash, sig)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return crypto.PubkeyToAddress(*pub)
+}
+
+func TestRecoverDefaultApiKeyCredentials(t *testing.T) {
+	// The mainnet credentials are EXPECTED_API_KEY_CREDENTIALS_MAINNET of
+	// the onboarding tests of the dydx3 Python client.
+	tests := []struct {
+		networkId int
+		want      types.ApiKeyCredentials
+	}{
+		{
+			networkId: constants.NETWORK_ID_MAINNET,
+			want: types.ApiKeyCredentials{
+				Key:        "50fdcaa0-62b8-e827-02e8-a9520d46cb9f",
+				Secret:     "rdHdKDAOCa0B_Mq-Q9kh8Fz6rK3ocZNOhKB4QsR9",
+				Passphrase: "12_1LuuJMZUxcj3kGBWc",
+			},
+		},
+		{
+			networkId: constants.NETWORK_ID_ROPSTEN,
+			want: types.ApiKeyCredentials{
+				Key:        "9c1d91a5-0a30-1ed4-2d3d-b840a479b965",
+				Secret:     "hHYEswFe5MHMm8gFb81Jas9b7iLQUicsVv5YBRMY",
+				Passphrase: "9z5Ew7m2DLQd87Xlk7Hd",
+			},
+		},
+	}
+	for _, tt := range tests {
+		c, err := New("http://localhost", time.Second, tt.networkId, ganacheKey(t))
+		if err != nil {
+			t.Fatal(err)
+		}
+		got, err := c.RecoverDefaultApiKeyCredentials()
+		if err != nil {
+			t.Fatalf("network %d: %v", tt.networkId, err)
+		}
+		if *got != tt.want {
+			t.Errorf("network %d: credentials = %+v, want %+v", tt.networkId, *got, tt.want)
+		}
+	}
+}
+
+func TestCreateUser(t *testing.T) {
+	key := ganacheKey(t)
+	address := crypto.PubkeyToAddress(key.PublicKey)
+	country := "CH"
+	req := &types.OnboardingRequest{
+		StarkKey:            "0x35e23a936e596969a6b3131cfccbd18b71779f28276d30e8215cd0d3e9252c2",
+		StarkKeyYCoordinate: "0x557d1a1be389d9921b9d16415eac12bd276b05e2564c4b30a7730ace13a0e19",
+		Country:             &country,
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/v3/onboarding" {
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL)
+		}
+		if got := r.Header.Get("DYDX-ETHEREUM-ADDRESS"); got != address.Hex() {
+			t.Errorf("DYDX-ETHEREUM-ADDRESS = %q, want %q", got, address.Hex())
+		}
+		hash := ethsigning.OnboardingActionHash(constants.NETWORK_ID_ROPSTEN, constants.OFF_CHAIN_ONBOARDING_ACTION)
+		if got := recoverSigner(t, hash, r.Header.Get("DYDX-SIGNATURE")); got != address {
+			t.Errorf("DYDX-SIGNATURE is signed by %s, want %s", got.Hex(), address.Hex())
+		}
+		body := map[string]string{}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Error(err)
+		}
+		want := map[string]string{
+			"starkKey":            req.StarkKey,
+			"starkKeyYCoordinate": req.StarkKeyYCoordinate,
+			"country":             country,
+		}
+		if len(body) != len(want) {
+			t.Errorf("body = %v, want %v", body, want)
+		}
+		for k, v := range want {
+			if body[k] != v {
+				t.Errorf("body[%q] = %q, want %q", k, body[k], v)
+			}
+		}
+		w.WriteHeader(http.StatusCreated)
+		w.Write([]byte(`{"apiKey":{"key":"k","secret":"s","passphrase":"p"},"user":{},"account":{}}`))
+	}))
+	defer server.Close()
+
+	c, err := New(server.URL, time.Second, constants.NETWORK_ID_ROPSTEN, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp, err := c.CreateUser(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := (types.ApiKeyCredentials{Key: "k", Secret: "s", Passphrase: "p"}); resp.ApiKey != want {
+		t.Errorf("api key = %+v, want %+v", resp.ApiKey, want)
+	}
+}
@@ -1,19 +1,26 @@
 package private
 
 import (
-	"bytes"
 	"crypto/hmac"
+	"crypto/rand"
 	"crypto/sha256"
 	"encoding/base64"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io/ioutil"
+	"math"
+	"math/big"
 	"net/http"
 	"net/url"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/tselementes/dydx-v3-go/constants"
+	"github.com/tselementes/dydx-v3-go/starkex"
 	"github.com/tselementes/dydx-v3-go/types"
 )
 
@@ -28,16 +35,17 @@ type Client struct {
 	host              string
 	client            *http.Client
 	networkId         int
-	starkPrivateKey   string
+	starkSigner       *starkex.Signer
 	defaultAddress    common.Address
 	apiKeyCredentials map[string]string
+	cancelLimiter     *cancelRateLimiter
 }
 
 func New(
 	host string,
 	timeout time.Duration,
 	networkId int,
-	starkPrivateKey string,
+	starkSigner *starkex.Signer,
 	defaultAddress common.Address,
 	apiKeyCredentials map[string]string,
 ) (*Client, error) {
@@ -53,13 +61,31 @@ func New(
 			Timeout: timeout,
 		},
 		networkId:         networkId,
-		starkPrivateKey:   starkPrivateKey,
+		starkSigner:       starkSigner,
 		defaultAddress:    defaultAddress,
 		apiKeyCredentials: apiKeyCredentials,
+		cancelLimiter:     &cancelRateLimiter{},
 	}, nil
 }
 
+// SetCancelOrderRateLimiting sets the cancel budgets of the account, as
+// returned in the exchange config of the public API. Cancel requests
+// that would exceed them fail with ErrCancelRateLimited without being
+// sent. Cancels are not rate limited until this is called.
+func (c Client) SetCancelOrderRateLimiting(limits types.CancelOrderRateLimiting) {
+	c.cancelLimiter.setLimits(limits)
+}
+
 func (c Client) doRequest(method, path string, urlParams map[string]string, data []byte) (*http.Response, error) {
+	req, err := c.newRequest(method, path, urlParams, data)
+	if err != nil {
+		return nil, err
+	}
+	return c.send(req)
+}
+
+// newRequest builds and signs a request without sending it.
+func (c Client) newRequest(method, path string, urlParams map[string]string, data []byte) (*http.Request, error) {
 	host, err := url.Parse(c.host)
 	if err != nil {
 		return nil, fmt.Errorf("failed to parse host (%s): %w", c.host, err)
@@ -74,45 +100,53 @@ func (c Client) doRequest(method, path string, urlParams map[string]string, data
 		host.RawQuery = q.Encode()
 	}
 
-	req, err := http.NewRequest(method, host.String(), bytes.NewReader(data))
+	// The body that is sent must be identical to the one that is
+	// signed, otherwise the signature check fails on the server.
+	var body string
+	if len(data) > 0 {
+		body, err = jsonStringifyWithoutNils(data)
+		if err != nil {
+			return nil, fmt.Errorf("cannot stringify JSON: %w", err)
+		}
+	}
+
+	req, err := http.NewRequest(method, host.String(), strings.NewReader(body))
 	if err != nil {
 		return nil, fmt.Errorf("failed to build new request: %w", err)
 	}
 
 	now := time.Now().UTC().Format(time.RFC3339)
-	signature, err := c.sign(method, host.Path, now, data)
+	// The signed path includes the query string.
+	signature, err := c.sign(method, host.RequestURI(), now, body)
 	if err != nil {
 		return nil, fmt.Errorf("failed to sign request: %w", err)
 	}
 
+	if body != "" {
+		req.Header.Set("Content-Type", "application/json")
+	}
 	req.Header.Set("DYDX-SIGNATURE", signature)
 	req.Header.Set("DYDX-API-KEY", c.apiKeyCredentials[Key])
 	req.Header.Set("DYDX-TIMESTAMP", now)
 	req.Header.Set("DYDX-PASSPHRASE", c.apiKeyCredentials[Passphrase])
+	return req, nil
+}
 
-	// execute the request
+// send executes req. An error means that no response was received, but
+// the request may still have reached the server.
+func (c Client) send(req *http.Request) (*http.Response, error) {
 	resp, err := c.client.Do(req)
 	if err != nil {
-		return nil, fmt.Errorf("failed to %s %s: %w", method, host.Path, err)
+		return nil, fmt.Errorf("failed to %s %s: %w", req.Method, req.URL.Path, err)
 	}
 	return resp, nil
 }
 
-func (c Client) sign(method, path, timestamp string, data []byte) (string, error) {
-	var dataJSON string
-	var err error
-
-	if len(data) > 0 {
-		dataJSON, err = jsonStringifyWithoutNils(data)
-		if err != nil {
-			return "", fmt.Errorf("cannot stringify JSON: %w", err)
-		}
-	}
-
+func (c Client) sign(method, path, timestamp, body string) (string, error) {
 	message := timestamp +
 		method +
 		path +
-		dataJSON
+		body
 
 	s, err := base64.URLEncoding.DecodeString(c.apiKeyCredentials[Secret])
 	if err != nil {
@@ -164,10 +198,43 @@ func (c Client) get(path string, urlParams map[string]string) ([]byte, error) {
 	if err != nil {
 		return nil, err
 	}
+	defer resp.Body.Close()
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
 	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("unexpected response status: %v", resp.Status)
+		return nil, &APIError{StatusCode: resp.StatusCode, Status: resp.Status, Body: body}
+	}
+	return body, nil
+}
+
+// APIError is returned when the API answers a request with an
+// unexpected status, which means that the request reached the server.
+type APIError struct {
+	// The status code of the response.
+	StatusCode int
+	// The status of the response, e.g. "400 Bad Request".
+	Status string
+	// The body of the response.
+	Body []byte
+}
+
+func (e *APIError) Error() string {
+	return fmt.Sprintf("unexpected response status: %v: %s", e.Status, e.Body)
+}
+
+// readResponse reads the body of resp and fails on non-2xx statuses.
+func readResponse(resp *http.Response) ([]byte, error) {
+	defer resp.Body.Close()
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return nil, &APIError{StatusCode: resp.StatusCode, Status: resp.Status, Body: body}
 	}
-	return ioutil.ReadAll(resp.Body)
+	return body, nil
 }
 
 // Does not handle HTTP errors.
@@ -180,6 +247,27 @@ func (c Client) put(path string, data []byte) (*http.Response, error) {
 	return c.doRequest(http.MethodPut, path, nil, data)
 }
 
+// cancel sends a cancel request to path once consume has reserved its
+// points. The points are given back if the request cannot be built, but
+// not once it has been sent, since the server may count it even if no
+// response arrives.
+func (c Client) cancel(consume func() (func(), error), path string, urlParams map[string]string) ([]byte, error) {
+	release, err := consume()
+	if err != nil {
+		return nil, err
+	}
+	req, err := c.newRequest(http.MethodDelete, path, urlParams, nil)
+	if err != nil {
+		release()
+		return nil, err
+	}
+	resp, err := c.send(req)
+	if err != nil {
+		return nil, err
+	}
+	return readResponse(resp)
+}
+
 // Does not handle HTTP errors.
 func (c Client) delete(path string, urlParams map[string]string) (*http.Response, error) {
 	return c.doRequest(http.MethodDelete, path, urlParams, nil)
@@ -247,6 +335,19 @@ func (c Client) UpdateUser(req *types.UpdateUserRequest) (*types.User, error) {
 	return uResp.User, nil
 }
 
+// GetProfile fetches the private profile of the user.
+func (c Client) GetProfile() (*types.PrivateProfile, error) {
+	data, err := c.get("profile/private", nil)
+	if err != nil {
+		return nil, err
+	}
+	resp := &types.PrivateProfile{}
+	if err := json.Unmarshal(data, resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
 // GetAccount fetches ethereumAddress or if ethereumAddress is nil, it will
 // default to defaultAddress which is the default address the Client was
 // initialized with.
@@ -346,6 +447,43 @@ func (c Client) GetOrders(filters *types.GetOrdersFilter) ([]*types.Order, error
 	return resp.Orders, nil
 }
 
+// GetActiveOrders fetches the active orders of the account in market,
+// optionally only the ones on side or the one with the given id. It is
+// subject to a much lighter rate limit than GetOrders, but only the id,
+// account id, market, side, price and remaining size of the returned
+// orders are set. id can only be provided together with side.
+func (c Client) GetActiveOrders(market string, side, id *string) ([]*types.Order, error) {
+	params, err := activeOrdersParams(market, side, id)
+	if err != nil {
+		return nil, err
+	}
+	data, err := c.get("active-orders", params)
+	if err != nil {
+		return nil, err
+	}
+	resp := &types.GetActiveOrdersResponse{}
+	if err := json.Unmarshal(data, resp); err != nil {
+		return nil, err
+	}
+	return resp.Orders, nil
+}
+
+func activeOrdersParams(market string, side, id *string) (map[string]string, error) {
+	params := map[string]string{
+		"market": market,
+	}
+	if side != nil {
+		params["side"] = *side
+	}
+	if id != nil {
+		if side == nil {
+			return nil, errors.New("side is required when looking up an active order by id")
+		}
+		params["id"] = *id
+	}
+	return params, nil
+}
+
 // GetOrderByID fetches an order by its id
 func (c Client) GetOrderByID(id string) (*types.Order, error) {
 	data, err := c.get(fmt.Sprintf("orders/%s", id), nil)
@@ -372,7 +510,392 @@ func (c Client) GetOrderByClientID(id string) (*types.Order, error) {
 	return resp.Order, nil
 }
 
-func (c Client) CreateOrder(req *types.OrderRequest) (*types.Order, error) {
-	// TODO: FILLME
-	return nil, nil
+// GetFills fetches the fills of the account, optionally filtered.
+func (c Client) GetFills(filters *types.GetFillsFilter) ([]*types.Fill, error) {
+	params := make(map[string]string)
+	if filters != nil {
+		if filters.Market != nil {
+			params["market"] = *filters.Market
+		}
+		if filters.OrderID != nil {
+			params["orderId"] = *filters.OrderID
+		}
+		if filters.Limit != nil {
+			params["limit"] = *filters.Limit
+		}
+		if filters.CreatedBeforeOrAt != nil {
+			params["createdBeforeOrAt"] = *filters.CreatedBeforeOrAt
+		}
+	}
+	data, err := c.get("fills", params)
+	if err != nil {
+		return nil, err
+	}
+	resp := &types.GetFillsResponse{}
+	if err := json.Unmarshal(data, resp); err != nil {
+		return nil, err
+	}
+	return resp.Fills, nil
+}
+
+// CreateOrder places a new order for the position with id positionId.
+// A random client id is generated if req.ClientID is empty and the order
+// is signed with the STARK private key of the Client if req.Signature is
+// empty. req is not modified.
+func (c Client) CreateOrder(req *types.OrderRequest, positionId string) (*types.Order, error) {
+	order := *req
+	if err := c.prepareOrder(&order, positionId); err != nil {
+		return nil, err
+	}
+	resp := &types.CreateOrderResponse{}
+	if err := c.postJSON("orders", &order, resp); err != nil {
+		return nil, err
+	}
+	return resp.Order, nil
+}
+
+// prepareOrder fills in the defaults of order and signs it if needed.
+func (c Client) prepareOrder(order *types.OrderRequest, positionId string) error {
+	if order.TimeInForce == "" {
+		order.TimeInForce = constants.TIME_IN_FORCE_GTT
+	}
+	return c.signIfUnsigned(&order.ClientID, &order.Signature, func() (starkex.Signable, error) {
+		return starkex.NewSignableOrder(c.networkId, positionId, order)
+	})
+}
+
+// CancelOrder cancels the order with the given id and returns it.
+func (c Client) CancelOrder(id string) (*types.Order, error) {
+	body, err := c.cancel(c.cancelLimiter.consumeSingle, "orders/"+id, nil)
+	if err != nil {
+		return nil, err
+	}
+	cResp := &types.CancelOrderResponse{}
+	if err := json.Unmarshal(body, cResp); err != nil {
+		return nil, err
+	}
+	return cResp.CancelOrder, nil
+}
+
+// CancelAllOrders cancels all orders of the account in market, or in all
+// markets if market is nil, and returns them.
+func (c Client) CancelAllOrders(market *string) ([]*types.Order, error) {
+	params := make(map[string]string)
+	if market != nil {
+		params["market"] = *market
+	}
+	body, err := c.cancel(c.cancelLimiter.consumeMulti, "orders", params)
+	if err != nil {
+		return nil, err
+	}
+	cResp := &types.CancelOrdersResponse{}
+	if err := json.Unmarshal(body, cResp); err != nil {
+		return nil, err
+	}
+	return cResp.CancelOrders, nil
+}
+
+// CancelActiveOrders cancels the active orders of the account in market,
+// optionally only the ones on side or the one with the given id, and
+// returns them. id can only be provided together with side. Only the id,
+// account id, market, side, price and remaining size of the returned
+// orders are set.
+func (c Client) CancelActiveOrders(market string, side, id *string) ([]*types.Order, error) {
+	params, err := activeOrdersParams(market, side, id)
+	if err != nil {
+		return nil, err
+	}
+	body, err := c.cancel(c.cancelLimiter.consumeMulti, "active-orders", params)
+	if err != nil {
+		return nil, err
+	}
+	cResp := &types.CancelOrdersResponse{}
+	if err := json.Unmarshal(body, cResp); err != nil {
+		return nil, err
+	}
+	return cResp.CancelOrders, nil
+}
+
+// GetTransfers fetches the deposits, withdrawals and transfers of the
+// account, optionally filtered.
+func (c Client) GetTransfers(filters *types.GetTransfersFilter) ([]*types.Transfer, error) {
+	params := make(map[string]string)
+	if filters != nil {
+		if filters.Type != nil {
+			params["transferType"] = *filters.Type
+		}
+		if filters.Limit != nil {
+			params["limit"] = *filters.Limit
+		}
+		if filters.CreatedBeforeOrAt != nil {
+			params["createdBeforeOrAt"] = *filters.CreatedBeforeOrAt
+		}
+	}
+	data, err := c.get("transfers", params)
+	if err != nil {
+		return nil, err
+	}
+	resp := &types.GetTransfersResponse{}
+	if err := json.Unmarshal(data, resp); err != nil {
+		return nil, err
+	}
+	return resp.Transfers, nil
+}
+
+// GetFundingPayments fetches the funding payments of the account,
+// optionally filtered.
+func (c Client) GetFundingPayments(filters *types.GetFundingPaymentsFilter) ([]*types.FundingPayment, error) {
+	params := make(map[string]string)
+	if filters != nil {
+		if filters.Market != nil {
+			params["market"] = *filters.Market
+		}
+		if filters.Limit != nil {
+			params["limit"] = *filters.Limit
+		}
+		if filters.EffectiveBeforeOrAt != nil {
+			params["effectiveBeforeOrAt"] = *filters.EffectiveBeforeOrAt
+		}
+	}
+	data, err := c.get("funding", params)
+	if err != nil {
+		return nil, err
+	}
+	resp := &types.GetFundingPaymentsResponse{}
+	if err := json.Unmarshal(data, resp); err != nil {
+		return nil, err
+	}
+	return resp.FundingPayments, nil
+}
+
+// GetHistoricalPnl fetches the historical PnL ticks of the account,
+// optionally bounded by their creation time.
+func (c Client) GetHistoricalPnl(filters *types.GetHistoricalPnlFilter) ([]*types.HistoricalPnlTick, error) {
+	params := make(map[string]string)
+	if filters != nil {
+		if filters.CreatedBeforeOrAt != nil {
+			params["createdBeforeOrAt"] = *filters.CreatedBeforeOrAt
+		}
+		if filters.CreatedOnOrAfter != nil {
+			params["createdOnOrAfter"] = *filters.CreatedOnOrAfter
+		}
+	}
+	data, err := c.get("historical-pnl", params)
+	if err != nil {
+		return nil, err
+	}
+	resp := &types.GetHistoricalPnlResponse{}
+	if err := json.Unmarshal(data, resp); err != nil {
+		return nil, err
+	}
+	return resp.HistoricalPnl, nil
+}
+
+// GetTradingRewards fetches the trading rewards of the user in epoch, or
+// in the current epoch if epoch is nil.
+func (c Client) GetTradingRewards(epoch *string) (*types.TradingRewards, error) {
+	params := make(map[string]string)
+	if epoch != nil {
+		params["epoch"] = *epoch
+	}
+	data, err := c.get("rewards/weight", params)
+	if err != nil {
+		return nil, err
+	}
+	resp := &types.TradingRewards{}
+	if err := json.Unmarshal(data, resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// GetLiquidityProviderRewards fetches the liquidity provider rewards of
+// the user in epoch, or in the current epoch if epoch is nil.
+func (c Client) GetLiquidityProviderRewards(epoch *string) (*types.LiquidityProviderRewards, error) {
+	params := make(map[string]string)
+	if epoch != nil {
+		params["epoch"] = *epoch
+	}
+	data, err := c.get("rewards/liquidity", params)
+	if err != nil {
+		return nil, err
+	}
+	resp := &types.LiquidityProviderRewards{}
+	if err := json.Unmarshal(data, resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// GetRetroactiveMiningRewards fetches the retroactive mining rewards of
+// the user.
+func (c Client) GetRetroactiveMiningRewards() (*types.RetroactiveMiningRewards, error) {
+	data, err := c.get("rewards/retroactive-mining", nil)
+	if err != nil {
+		return nil, err
+	}
+	resp := &types.RetroactiveMiningRewards{}
+	if err := json.Unmarshal(data, resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// Withdraw withdraws collateral from the position with id positionId to
+// L1. A random client id is generated if req.ClientID is empty and the
+// withdrawal is signed with the STARK private key of the Client if
+// req.Signature is empty. req is not modified.
+func (c Client) Withdraw(req *types.WithdrawalRequest, positionId string) (*types.Transfer, error) {
+	withdrawal := *req
+	if withdrawal.Asset == "" {
+		withdrawal.Asset = constants.COLLATERAL_ASSET
+	}
+	resp := &types.WithdrawalResponse{}
+	err := c.signAndPost("withdrawals", &withdrawal.ClientID, &withdrawal.Signature, func() (starkex.Signable, error) {
+		return starkex.NewSignableWithdrawal(c.networkId, positionId, &withdrawal)
+	}, &withdrawal, resp)
+	if err != nil {
+		return nil, err
+	}
+	return resp.Withdrawal, nil
+}
+
+// CreateFastWithdrawal withdraws collateral from the position with id
+// positionId to L1 through a liquidity provider. req.LpPositionId and
+// req.LpStarkKey must be set to the position id and stark key of a
+// liquidity provider returned by the public fast withdrawals endpoint,
+// and the amounts must match its quote. A random client id is generated
+// if req.ClientID is empty and the withdrawal is signed with the STARK
+// private key of the Client if req.Signature is empty. req is not
+// modified.
+func (c Client) CreateFastWithdrawal(req *types.FastWithdrawalRequest, positionId string) (*types.Transfer, error) {
+	withdrawal := *req
+	if withdrawal.CreditAsset == "" {
+		withdrawal.CreditAsset = constants.COLLATERAL_ASSET
+	}
+	withdrawal.ToAddress = strings.ToLower(withdrawal.ToAddress)
+	resp := &types.WithdrawalResponse{}
+	err := c.signAndPost("fast-withdrawals", &withdrawal.ClientID, &withdrawal.Signature, func() (starkex.Signable, error) {
+		return starkex.NewSignableFastWithdrawal(c.networkId, positionId, &withdrawal)
+	}, &withdrawal, resp)
+	if err != nil {
+		return nil, err
+	}
+	return resp.Withdrawal, nil
+}
+
+// Transfer transfers collateral from the position with id positionId to
+// another L2 account. req.ReceiverPublicKey and req.ReceiverPositionId
+// must be set to the stark key and position id of the receiving account.
+// A random client id is generated if req.ClientID is empty and the
+// transfer is signed with the STARK private key of the Client if
+// req.Signature is empty. req is not modified.
+func (c Client) Transfer(req *types.TransferRequest, positionId string) (*types.Transfer, error) {
+	transfer := *req
+	resp := &types.TransferResponse{}
+	err := c.signAndPost("transfers", &transfer.ClientID, &transfer.Signature, func() (starkex.Signable, error) {
+		return starkex.NewSignableTransfer(c.networkId, positionId, &transfer)
+	}, &transfer, resp)
+	if err != nil {
+		return nil, err
+	}
+	return resp.Transfer, nil
+}
+
+// RequestTestnetTokens requests testnet USDC to be deposited to the
+// account. It is only available on NETWORK_ID_ROPSTEN.
+func (c Client) RequestTestnetTokens() (*types.Transfer, error) {
+	if c.networkId != constants.NETWORK_ID_ROPSTEN {
+		return nil, fmt.Errorf("testnet tokens are not available on network %d", c.networkId)
+	}
+	resp, err := c.post("testnet/tokens", []byte("{}"))
+	if err != nil {
+		return nil, err
+	}
+	body, err := readResponse(resp)
+	if err != nil {
+		return nil, err
+	}
+	tResp := &types.TransferResponse{}
+	if err := json.Unmarshal(body, tResp); err != nil {
+		return nil, err
+	}
+	return tResp.Transfer, nil
+}
+
+// signIfUnsigned generates a random client id if *clientID is empty and,
+// if *signature is empty, signs the message returned by signable with the
+// STARK private key of the Client. signable is called once the client id
+// is set, since the nonce of the message is derived from it.
+func (c Client) signIfUnsigned(clientID, signature *string, signable func() (starkex.Signable, error)) error {
+	if *clientID == "" {
+		id, err := randomClientID()
+		if err != nil {
+			return err
+		}
+		*clientID = id
+	}
+	if *signature != "" {
+		return nil
+	}
+	message, err := signable()
+	if err != nil {
+		return err
+	}
+	sig, err := c.starkSign(message)
+	if err != nil {
+		return err
+	}
+	*signature = sig
+	return nil
+}
+
+// signAndPost signs req as described by signIfUnsigned, posts it to path
+// and decodes the response into out. req must point to the request that
+// clientID and signature belong to, so that they are sent once filled in.
+func (c Client) signAndPost(path string, clientID, signature *string, signable func() (starkex.Signable, error), req, out interface{}) error {
+	if err := c.signIfUnsigned(clientID, signature, signable); err != nil {
+		return err
+	}
+	return c.postJSON(path, req, out)
+}
+
+// postJSON posts req to path and decodes the response into out.
+func (c Client) postJSON(path string, req, out interface{}) error {
+	data, err := json.Marshal(req)
+	if err != nil {
+		return err
+	}
+	resp, err := c.post(path, data)
+	if err != nil {
+		return err
+	}
+	body, err := readResponse(resp)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(body, out)
+}
+
+// starkSign signs a StarkEx message with the STARK
+// private key of the Client.
+func (c Client) starkSign(signable starkex.Signable) (string, error) {
+	if c.starkSigner == nil {
+		return "", errors.New("stark private key is required for signing")
+	}
+	h, err := signable.Hash()
+	if err != nil {
+		return "", fmt.Errorf("cannot hash message: %w", err)
+	}
+	return c.starkSigner.Sign(h)
+}
+
+// randomClientID generates a client id for requests that do not
+// provide one.
+func randomClientID() (string, error) {
+	n, err := rand.Int(rand.Reader, big.NewInt(math.MaxInt64))
+	if err != nil {
+		return "", fmt.Errorf("cannot generate client id: %w", err)
+	}
+	return n.String(), nil
 }
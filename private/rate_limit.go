@@ -0,0 +1,107 @@
+package private
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/tselementes/dydx-v3-go/types"
+)
+
+// ErrCancelRateLimited is returned by cancel requests that would exceed
+// the cancel budget of the account.
+var ErrCancelRateLimited = errors.New("cancel order rate limit exceeded")
+
+// Every cancel request consumes a single point of its budget.
+const cancelPoints = 1
+
+// cancelRateLimiter keeps track of the points consumed by cancel requests
+// so that they are rejected locally instead of by the server. Cancels of a
+// single order and cancels of multiple orders have separate budgets.
+type cancelRateLimiter struct {
+	mu     sync.Mutex
+	single rateBudget
+	multi  rateBudget
+}
+
+// rateBudget allows maxPoints to be consumed in any sliding window of
+// length window. A zero maxPoints disables the limit.
+type rateBudget struct {
+	maxPoints int
+	window    time.Duration
+	consumed  []time.Time
+}
+
+func (l *cancelRateLimiter) setLimits(limits types.CancelOrderRateLimiting) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.single.maxPoints = int(limits.MaxPointsSingle)
+	l.single.window = time.Duration(limits.WindowSecSingle) * time.Second
+	l.multi.maxPoints = int(limits.MaxPointsMulti)
+	l.multi.window = time.Duration(limits.WindowSecMulti) * time.Second
+}
+
+// consumeSingle reserves the points of a cancel of a single order. The
+// returned release func gives them back if the request is not sent.
+func (l *cancelRateLimiter) consumeSingle() (release func(), err error) {
+	return l.reserve(&l.single)
+}
+
+// consumeMulti reserves the points of a cancel of multiple orders. The
+// returned release func gives them back if the request is not sent.
+func (l *cancelRateLimiter) consumeMulti() (release func(), err error) {
+	return l.reserve(&l.multi)
+}
+
+func (l *cancelRateLimiter) reserve(b *rateBudget) (func(), error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	now := time.Now()
+	if err := b.consume(now, cancelPoints); err != nil {
+		return nil, err
+	}
+	return func() {
+		l.mu.Lock()
+		defer l.mu.Unlock()
+		b.release(now, cancelPoints)
+	}, nil
+}
+
+// consume records that points are spent at now, or fails with
+// ErrCancelRateLimited if that would exceed the budget.
+func (b *rateBudget) consume(now time.Time, points int) error {
+	if b.maxPoints <= 0 {
+		return nil
+	}
+	if points > b.maxPoints {
+		return fmt.Errorf("%w: %d points exceed the budget of %d", ErrCancelRateLimited, points, b.maxPoints)
+	}
+	// Drop the points that are out of the window.
+	start := now.Add(-b.window)
+	i := 0
+	for i < len(b.consumed) && !b.consumed[i].After(start) {
+		i++
+	}
+	b.consumed = b.consumed[i:]
+
+	if len(b.consumed)+points > b.maxPoints {
+		retryAt := b.consumed[len(b.consumed)+points-b.maxPoints-1].Add(b.window)
+		return fmt.Errorf("%w: retry in %v", ErrCancelRateLimited, retryAt.Sub(now).Round(time.Millisecond))
+	}
+	for j := 0; j < points; j++ {
+		b.consumed = append(b.consumed, now)
+	}
+	return nil
+}
+
+// release gives back points that were consumed at at. Points that have
+// already left the window are ignored.
+func (b *rateBudget) release(at time.Time, points int) {
+	for i := len(b.consumed) - 1; i >= 0 && points > 0; i-- {
+		if b.consumed[i].Equal(at) {
+			b.consumed = append(b.consumed[:i], b.consumed[i+1:]...)
+			points--
+		}
+	}
+}
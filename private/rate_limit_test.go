@@ -0,0 +1,197 @@
+package private
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/tselementes/dydx-v3-go/constants"
+	"github.com/tselementes/dydx-v3-go/starkex"
+	"github.com/tselementes/dydx-v3-go/types"
+)
+
+func TestRateBudgetConsume(t *testing.T) {
+	t0 := time.Date(2021, 11, 1, 0, 0, 0, 0, time.UTC)
+	at := func(sec float64) time.Time {
+		return t0.Add(time.Duration(sec * float64(time.Second)))
+	}
+
+	type step struct {
+		now     time.Time
+		points  int
+		wantErr string
+	}
+	tests := []struct {
+		name   string
+		budget rateBudget
+		steps  []step
+	}{
+		{
+			name:   "disabled",
+			budget: rateBudget{},
+			steps: []step{
+				{at(0), 1, ""},
+				{at(0), 100, ""},
+				{at(0), 1, ""},
+			},
+		},
+		{
+			name:   "full window",
+			budget: rateBudget{maxPoints: 2, window: 10 * time.Second},
+			steps: []step{
+				{at(0), 1, ""},
+				{at(1), 1, ""},
+				{at(2), 1, "retry in 8s"},
+				{at(9.5), 1, "retry in 500ms"},
+			},
+		},
+		{
+			name:   "points expire at the end of the window",
+			budget: rateBudget{maxPoints: 2, window: 10 * time.Second},
+			steps: []step{
+				{at(0), 1, ""},
+				{at(1), 1, ""},
+				{at(10), 1, ""},
+				{at(10.5), 1, "retry in 500ms"},
+				{at(11), 1, ""},
+			},
+		},
+		{
+			name:   "several points wait for enough expiries",
+			budget: rateBudget{maxPoints: 3, window: 10 * time.Second},
+			steps: []step{
+				{at(0), 1, ""},
+				{at(1), 1, ""},
+				{at(2), 1, ""},
+				{at(3), 2, "retry in 8s"},
+				{at(11), 2, ""},
+			},
+		},
+		{
+			name:   "more points than the budget",
+			budget: rateBudget{maxPoints: 2, window: 10 * time.Second},
+			steps: []step{
+				{at(0), 3, "exceed the budget"},
+				{at(0), 2, ""},
+			},
+		},
+	}
+	for _, tt := range tests {
+		b := tt.budget
+		for i, s := range tt.steps {
+			err := b.consume(s.now, s.points)
+			if s.wantErr == "" {
+				if err != nil {
+					t.Errorf("%s: step %d: unexpected error: %v", tt.name, i, err)
+				}
+				continue
+			}
+			if !errors.Is(err, ErrCancelRateLimited) || !strings.Contains(err.Error(), s.wantErr) {
+				t.Errorf("%s: step %d: got error %v, want %q", tt.name, i, err, s.wantErr)
+			}
+		}
+	}
+}
+
+func TestRateBudgetRelease(t *testing.T) {
+	t0 := time.Date(2021, 11, 1, 0, 0, 0, 0, time.UTC)
+	b := rateBudget{maxPoints: 2, window: 10 * time.Second}
+	if err := b.consume(t0, 1); err != nil {
+		t.Fatal(err)
+	}
+	if err := b.consume(t0.Add(time.Second), 1); err != nil {
+		t.Fatal(err)
+	}
+	b.release(t0.Add(time.Second), 1)
+	if err := b.consume(t0.Add(2*time.Second), 1); err != nil {
+		t.Errorf("released point is still consumed: %v", err)
+	}
+	if err := b.consume(t0.Add(3*time.Second), 1); err == nil {
+		t.Error("expected the budget to be exhausted")
+	}
+}
+
+func newTestClient(t *testing.T, host string) *Client {
+	t.Helper()
+	signer, err := starkex.NewSigner("", "0x58c7d5a90b1776bde86ebac077e053ed85b0f7164f53b080304a531947f46e3", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	c, err := New(host, time.Second, constants.NETWORK_ID_ROPSTEN, signer, common.Address{}, map[string]string{
+		Key:        "key",
+		Passphrase: "passphrase",
+		Secret:     "c2VjcmV0",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return c
+}
+
+func TestCancelOrderRateLimited(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.Write([]byte(`{"cancelOrder":{"id":"1"}}`))
+	}))
+	defer server.Close()
+
+	c := newTestClient(t, server.URL)
+	c.SetCancelOrderRateLimiting(types.CancelOrderRateLimiting{MaxPointsSingle: 1, WindowSecSingle: 60})
+	if _, err := c.CancelOrder("1"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := c.CancelOrder("1"); !errors.Is(err, ErrCancelRateLimited) {
+		t.Errorf("got error %v, want ErrCancelRateLimited", err)
+	}
+	if requests != 1 {
+		t.Errorf("server got %d requests, want 1", requests)
+	}
+}
+
+func TestCancelOrderKeepsPointsOfDroppedRequests(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		conn, _, err := w.(http.Hijacker).Hijack()
+		if err != nil {
+			panic(err)
+		}
+		conn.Close()
+	}))
+	defer server.Close()
+
+	c := newTestClient(t, server.URL)
+	c.SetCancelOrderRateLimiting(types.CancelOrderRateLimiting{MaxPointsSingle: 1, WindowSecSingle: 60})
+	if _, err := c.CancelOrder("1"); err == nil || errors.Is(err, ErrCancelRateLimited) {
+		t.Fatalf("got error %v, want a transport error", err)
+	}
+	// The server may have counted the dropped request.
+	if _, err := c.CancelOrder("1"); !errors.Is(err, ErrCancelRateLimited) {
+		t.Errorf("got error %v, want ErrCancelRateLimited", err)
+	}
+}
+
+func TestCancelOrderReleasesPointsOfUnsentRequests(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+	}))
+	defer server.Close()
+
+	c := newTestClient(t, server.URL)
+	// Requests cannot be signed with a secret that is not base64.
+	c.apiKeyCredentials[Secret] = "not base64!"
+	c.SetCancelOrderRateLimiting(types.CancelOrderRateLimiting{MaxPointsSingle: 1, WindowSecSingle: 60})
+	for i := 0; i < 2; i++ {
+		if _, err := c.CancelOrder("1"); err == nil || errors.Is(err, ErrCancelRateLimited) {
+			t.Errorf("attempt %d: got error %v, want a signing error", i, err)
+		}
+	}
+	if requests != 0 {
+		t.Errorf("server got %d requests, want 0", requests)
+	}
+}
@@ -0,0 +1,181 @@
+package private
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/tselementes/dydx-v3-go/types"
+)
+
+// OrderChange modifies the order that replaces an existing one.
+type OrderChange func(*types.OrderRequest)
+
+// WithPrice changes the price of the order.
+func WithPrice(price string) OrderChange {
+	return func(req *types.OrderRequest) {
+		req.Price = price
+	}
+}
+
+// WithSize changes the size of the order.
+func WithSize(size string) OrderChange {
+	return func(req *types.OrderRequest) {
+		req.Size = size
+	}
+}
+
+// WithLimitFee sets the highest accepted fee of the order.
+func WithLimitFee(limitFee string) OrderChange {
+	return func(req *types.OrderRequest) {
+		req.LimitFee = limitFee
+	}
+}
+
+// WithExpiration changes the expiration of the order.
+func WithExpiration(expiration string) OrderChange {
+	return func(req *types.OrderRequest) {
+		req.Expiration = expiration
+	}
+}
+
+// WithPostOnly changes whether the order is post-only.
+func WithPostOnly(postOnly bool) OrderChange {
+	return func(req *types.OrderRequest) {
+		req.PostOnly = postOnly
+	}
+}
+
+// WithTriggerPrice changes the trigger price of the order.
+func WithTriggerPrice(triggerPrice string) OrderChange {
+	return func(req *types.OrderRequest) {
+		req.TriggerPrice = &triggerPrice
+	}
+}
+
+// WithClientID sets the client id of the order instead of a random one.
+func WithClientID(clientID string) OrderChange {
+	return func(req *types.OrderRequest) {
+		req.ClientID = clientID
+	}
+}
+
+// ReplaceStage is the half of an order replacement that failed.
+type ReplaceStage string
+
+const (
+	// The existing order could not be canceled because it is no longer
+	// open, so the new order was not placed either.
+	ReplaceStageCancel ReplaceStage = "cancel"
+	// The new order was rejected and the existing order is still open.
+	ReplaceStagePlace ReplaceStage = "place"
+	// The request failed but the state of the existing order could not
+	// be determined.
+	ReplaceStageUnknown ReplaceStage = "unknown"
+)
+
+// ReplaceOrderError is returned by ReplaceOrder when the replacement
+// fails.
+type ReplaceOrderError struct {
+	// The half of the replacement that failed.
+	Stage ReplaceStage
+	// The id of the order that was being replaced.
+	OrderID string
+	// The status of the existing order after the failure, if known.
+	Status types.OrderStatus
+	Err    error
+}
+
+func (e *ReplaceOrderError) Error() string {
+	return fmt.Sprintf("cannot replace order %s (%s failed): %v", e.OrderID, e.Stage, e.Err)
+}
+
+func (e *ReplaceOrderError) Unwrap() error {
+	return e.Err
+}
+
+// ReplaceOrder atomically cancels existing and places a new order in its
+// place. The new order has the same parameters as the remaining part of
+// existing, modified by changes, and is signed with the STARK private key
+// of the Client. The limit fee of existing is not known, so WithLimitFee
+// is required. On failure, a *ReplaceOrderError reports whether the
+// cancel or the place half failed. If the request fails without an answer
+// of the API, the new order is looked up by its client id and returned if
+// the replacement went through.
+func (c Client) ReplaceOrder(existing *types.Order, positionId string, changes ...OrderChange) (*types.Order, error) {
+	req := &types.OrderRequest{
+		Market:          existing.Market,
+		Side:            existing.Side,
+		Type:            existing.Type,
+		PostOnly:        existing.PostOnly,
+		Size:            existing.RemainingSize,
+		Price:           existing.Price,
+		Expiration:      existing.ExpiresAt,
+		TimeInForce:     existing.TimeInForce,
+		CancelID:        &existing.ID,
+		TriggerPrice:    existing.TriggerPrice,
+		TrailingPercent: existing.TrailingPercent,
+	}
+	for _, change := range changes {
+		change(req)
+	}
+	if err := c.prepareReplacement(req, positionId); err != nil {
+		return nil, &ReplaceOrderError{
+			Stage:   ReplaceStagePlace,
+			OrderID: existing.ID,
+			Status:  existing.Status,
+			Err:     err,
+		}
+	}
+
+	order, err := c.CreateOrder(req, positionId)
+	if err == nil {
+		return order, nil
+	}
+
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) {
+		// The request may or may not have reached the server. The
+		// new order only exists if the replacement went through.
+		order, getErr := c.GetOrderByClientID(req.ClientID)
+		if getErr != nil {
+			return nil, &ReplaceOrderError{
+				Stage:   ReplaceStageUnknown,
+				OrderID: existing.ID,
+				Err:     err,
+			}
+		}
+		return order, nil
+	}
+
+	// The server either applies both halves or none of them, so the
+	// existing order tells which half was rejected.
+	current, getErr := c.GetOrderByID(existing.ID)
+	if getErr != nil {
+		return nil, &ReplaceOrderError{
+			Stage:   ReplaceStageUnknown,
+			OrderID: existing.ID,
+			Err:     err,
+		}
+	}
+	stage := ReplaceStagePlace
+	if current.Status == types.Filled || current.Status == types.Canceled {
+		stage = ReplaceStageCancel
+	}
+	return nil, &ReplaceOrderError{
+		Stage:   stage,
+		OrderID: existing.ID,
+		Status:  current.Status,
+		Err:     err,
+	}
+}
+
+// prepareReplacement fills in the client id, time in force and signature
+// of req before it is sent, so that failures to do so are not mistaken
+// for failures of the request and the new order can be looked up by its
+// client id.
+func (c Client) prepareReplacement(req *types.OrderRequest, positionId string) error {
+	if req.LimitFee == "" {
+		return errors.New("limit fee is required")
+	}
+	return c.prepareOrder(req, positionId)
+}
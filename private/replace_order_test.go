@@ -0,0 +1,196 @@
+package private
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/tselementes/dydx-v3-go/constants"
+	"github.com/tselementes/dydx-v3-go/types"
+)
+
+func mockExistingOrder() *types.Order {
+	return &types.Order{
+		ID:            "existing",
+		Market:        constants.MARKET_ETH_USD,
+		Side:          constants.ORDER_SIDE_BUY,
+		Type:          types.OrderTypeLimit,
+		Size:          "2",
+		RemainingSize: "1",
+		Price:         "4000",
+		ExpiresAt:     "2021-12-01T00:00:00.000Z",
+		Status:        types.Open,
+		TimeInForce:   constants.TIME_IN_FORCE_GTT,
+	}
+}
+
+// replaceServer answers order placements with place. Lookups of the
+// existing order fail unless existingStatus is set, and lookups of the
+// placed order by client id fail unless placedFound is set.
+type replaceServer struct {
+	place          func(w http.ResponseWriter)
+	existingStatus types.OrderStatus
+	placedFound    bool
+
+	placed *types.OrderRequest
+}
+
+func (s *replaceServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	switch {
+	case r.Method == http.MethodPost && r.URL.Path == "/v3/orders":
+		s.placed = &types.OrderRequest{}
+		json.NewDecoder(r.Body).Decode(s.placed)
+		s.place(w)
+	case r.Method == http.MethodGet && r.URL.Path == "/v3/orders/existing":
+		if s.existingStatus == "" {
+			http.Error(w, `{"errors":[]}`, http.StatusInternalServerError)
+			return
+		}
+		json.NewEncoder(w).Encode(types.GetOrderByIdResponse{Order: &types.Order{ID: "existing", Status: s.existingStatus}})
+	case r.Method == http.MethodGet && s.placed != nil && r.URL.Path == "/v3/orders/client/"+s.placed.ClientID:
+		if !s.placedFound {
+			http.Error(w, `{"errors":[]}`, http.StatusNotFound)
+			return
+		}
+		json.NewEncoder(w).Encode(types.GetOrderByIdResponse{Order: &types.Order{ID: "new", ClientID: s.placed.ClientID}})
+	default:
+		http.Error(w, "unexpected request", http.StatusTeapot)
+	}
+}
+
+func placeOK(w http.ResponseWriter) {
+	json.NewEncoder(w).Encode(types.CreateOrderResponse{Order: &types.Order{ID: "new"}})
+}
+
+func placeRejected(w http.ResponseWriter) {
+	http.Error(w, `{"errors":[{"msg":"rejected"}]}`, http.StatusBadRequest)
+}
+
+// placeDropped closes the connection without answering, as if the
+// request had been lost in transit.
+func placeDropped(w http.ResponseWriter) {
+	conn, _, err := w.(http.Hijacker).Hijack()
+	if err != nil {
+		panic(err)
+	}
+	conn.Close()
+}
+
+func TestReplaceOrder(t *testing.T) {
+	tests := []struct {
+		name       string
+		server     *replaceServer
+		changes    []OrderChange
+		wantSent   bool
+		wantOrder  string
+		wantStage  ReplaceStage
+		wantStatus types.OrderStatus
+		wantAPIErr bool
+	}{
+		{
+			name:      "replaced",
+			server:    &replaceServer{place: placeOK},
+			wantSent:  true,
+			wantOrder: "new",
+		},
+		{
+			name:       "missing limit fee",
+			server:     &replaceServer{place: placeOK},
+			changes:    []OrderChange{WithLimitFee("")},
+			wantStage:  ReplaceStagePlace,
+			wantStatus: types.Open,
+		},
+		{
+			name:       "unsignable order",
+			server:     &replaceServer{place: placeOK},
+			changes:    []OrderChange{WithSize("0.0000000001")},
+			wantStage:  ReplaceStagePlace,
+			wantStatus: types.Open,
+		},
+		{
+			name:       "place rejected",
+			server:     &replaceServer{place: placeRejected, existingStatus: types.Open},
+			wantSent:   true,
+			wantStage:  ReplaceStagePlace,
+			wantStatus: types.Open,
+			wantAPIErr: true,
+		},
+		{
+			name:       "existing order filled",
+			server:     &replaceServer{place: placeRejected, existingStatus: types.Filled},
+			wantSent:   true,
+			wantStage:  ReplaceStageCancel,
+			wantStatus: types.Filled,
+			wantAPIErr: true,
+		},
+		{
+			name:       "existing order canceled",
+			server:     &replaceServer{place: placeRejected, existingStatus: types.Canceled},
+			wantSent:   true,
+			wantStage:  ReplaceStageCancel,
+			wantStatus: types.Canceled,
+			wantAPIErr: true,
+		},
+		{
+			name:       "existing order lookup fails",
+			server:     &replaceServer{place: placeRejected},
+			wantSent:   true,
+			wantStage:  ReplaceStageUnknown,
+			wantAPIErr: true,
+		},
+		{
+			name:      "dropped but replaced",
+			server:    &replaceServer{place: placeDropped, placedFound: true},
+			wantSent:  true,
+			wantOrder: "new",
+		},
+		{
+			name:      "dropped and not found",
+			server:    &replaceServer{place: placeDropped, existingStatus: types.Open},
+			wantSent:  true,
+			wantStage: ReplaceStageUnknown,
+		},
+	}
+	for _, tt := range tests {
+		server := httptest.NewServer(tt.server)
+		c := newTestClient(t, server.URL)
+		changes := append([]OrderChange{WithLimitFee("0.001"), WithPrice("3900")}, tt.changes...)
+		order, err := c.ReplaceOrder(mockExistingOrder(), "12345", changes...)
+		server.Close()
+
+		if sent := tt.server.placed != nil; sent != tt.wantSent {
+			t.Errorf("%s: order sent = %v, want %v", tt.name, sent, tt.wantSent)
+		}
+		if tt.wantSent {
+			p := tt.server.placed
+			if p.CancelID == nil || *p.CancelID != "existing" || p.Price != "3900" || p.Size != "1" || p.ClientID == "" || p.Signature == "" {
+				t.Errorf("%s: unexpected order sent: %+v", tt.name, p)
+			}
+		}
+
+		if tt.wantOrder != "" {
+			if err != nil {
+				t.Errorf("%s: unexpected error: %v", tt.name, err)
+			} else if order.ID != tt.wantOrder {
+				t.Errorf("%s: order id = %q, want %q", tt.name, order.ID, tt.wantOrder)
+			}
+			continue
+		}
+		var replaceErr *ReplaceOrderError
+		if !errors.As(err, &replaceErr) {
+			t.Errorf("%s: got error %v, want a *ReplaceOrderError", tt.name, err)
+			continue
+		}
+		if replaceErr.Stage != tt.wantStage || replaceErr.Status != tt.wantStatus || replaceErr.OrderID != "existing" {
+			t.Errorf("%s: got %+v, want stage %q and status %q", tt.name, replaceErr, tt.wantStage, tt.wantStatus)
+		}
+		var apiErr *APIError
+		if isAPIErr := errors.As(err, &apiErr); isAPIErr != tt.wantAPIErr {
+			t.Errorf("%s: got error %v, want an *APIError: %v", tt.name, err, tt.wantAPIErr)
+		} else if isAPIErr && apiErr.StatusCode != http.StatusBadRequest {
+			t.Errorf("%s: status code = %d, want 400", tt.name, apiErr.StatusCode)
+		}
+	}
+}
@@ -278,11 +278,21 @@ func (c Client) GetHistoricalFunding(market string, effectiveBeforeOrAt *string)
 // desired asset the user will be credited on L1. Given a creditAmount
 // and asset the user wants sent to L1, this endpoint also returns the
 // predicted amount the user will be debited on L2.
-// TODO: Use amounts if provided
+// The returned map is keyed by the position id of each LP.
 func (c Client) GetFastWithdrawal(creditAsset, creditAmount, debitAmount *string) (map[string]types.LiquidityProvider, error) {
 	path := "/fast-withdrawals"
+	params := make(map[string]string)
+	if creditAsset != nil {
+		params["creditAsset"] = *creditAsset
+	}
+	if creditAmount != nil {
+		params["creditAmount"] = *creditAmount
+	}
+	if debitAmount != nil {
+		params["debitAmount"] = *debitAmount
+	}
 
-	resp, err := c.get(path, nil)
+	resp, err := c.get(path, params)
 	if err != nil {
 		return nil, err
 	}
@@ -435,3 +445,84 @@ func (c Client) GetConfig() (*types.Config, error) {
 
 	return config, nil
 }
+
+// GetLeaderboardPnl fetches the top PnLs of period, which can be one of
+// DAILY, WEEKLY, MONTHLY, ALL_TIME, COMPETITION, DAILY_COMPETITION or
+// LEAGUES. sortBy can be ABSOLUTE or PERCENT.
+func (c Client) GetLeaderboardPnl(period string, startingBeforeOrAt, sortBy, limit *string) (*types.LeaderboardPnl, error) {
+	path := "/leaderboard-pnl"
+	params := map[string]string{
+		"period": period,
+	}
+	if startingBeforeOrAt != nil {
+		params["startingBeforeOrAt"] = *startingBeforeOrAt
+	}
+	if sortBy != nil {
+		params["sortBy"] = *sortBy
+	}
+	if limit != nil {
+		params["limit"] = *limit
+	}
+
+	resp, err := c.get(path, params)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	lp := &types.LeaderboardPnl{}
+	if err := json.Unmarshal(body, lp); err != nil {
+		return nil, err
+	}
+
+	return lp, nil
+}
+
+// GetPublicProfile fetches the public profile of the user with the given
+// public id.
+func (c Client) GetPublicProfile(publicId string) (*types.PublicProfile, error) {
+	path := "/profile/" + publicId
+
+	resp, err := c.get(path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	p := &types.PublicProfile{}
+	if err := json.Unmarshal(body, p); err != nil {
+		return nil, err
+	}
+
+	return p, nil
+}
+
+// GetInsuranceFundBalance fetches the balance of the dYdX insurance fund.
+func (c Client) GetInsuranceFundBalance() (*types.InsuranceFundBalance, error) {
+	path := "/insurance-fund/balance"
+
+	resp, err := c.get(path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	b := &types.InsuranceFundBalance{}
+	if err := json.Unmarshal(body, b); err != nil {
+		return nil, err
+	}
+
+	return b, nil
+}
@@ -0,0 +1,243 @@
+package starkex
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/tselementes/dydx-v3-go/constants"
+	"github.com/tselementes/dydx-v3-go/types"
+)
+
+const (
+	conditionalTransferPrefix      = 5
+	conditionalTransferPaddingBits = 81
+
+	transferAssetIDBits              = 250
+	transferReceiverPublicKeyBits    = 251
+	transferPositionIDBits           = 64
+	transferConditionBits            = 251
+	transferQuantumsAmountBits       = 64
+	transferExpirationEpochHoursBits = 32
+)
+
+// SignableConditionalTransfer is a StarkEx transfer that only goes through
+// once a fact has been registered in a fact registry contract on L1. It is
+// used for fast withdrawals, where a liquidity provider sends funds on L1
+// in exchange for an L2 transfer.
+type SignableConditionalTransfer struct {
+	AssetIDCollateral    *big.Int
+	SenderPositionID     *big.Int
+	ReceiverPositionID   *big.Int
+	ReceiverPublicKey    *big.Int
+	Condition            *big.Int
+	QuantumsAmount       *big.Int
+	Nonce                *big.Int
+	ExpirationEpochHours *big.Int
+}
+
+// NewSignableFastWithdrawal converts a fast withdrawal request from
+// positionId into the StarkEx conditional transfer to the liquidity
+// provider that needs to be signed.
+func NewSignableFastWithdrawal(networkId int, positionId string, req *types.FastWithdrawalRequest) (*SignableConditionalTransfer, error) {
+	tokenAddress, ok := constants.TOKEN_CONTRACTS[constants.COLLATERAL_ASSET][networkId]
+	if !ok {
+		return nil, fmt.Errorf("unknown network id: %d", networkId)
+	}
+	factRegistryAddress, ok := constants.FACT_REGISTRY_CONTRACT[networkId]
+	if !ok {
+		return nil, fmt.Errorf("unknown network id: %d", networkId)
+	}
+	if !common.IsHexAddress(req.ToAddress) {
+		return nil, fmt.Errorf("invalid address: %s", req.ToAddress)
+	}
+	fact, err := TransferERC20Fact(
+		common.HexToAddress(req.ToAddress),
+		constants.COLLATERAL_TOKEN_DECIMALS,
+		req.CreditAmount,
+		common.HexToAddress(tokenAddress),
+		NonceFromClientID(req.ClientID),
+	)
+	if err != nil {
+		return nil, err
+	}
+	receiverPublicKey, err := parseHex(req.LpStarkKey)
+	if err != nil {
+		return nil, fmt.Errorf("invalid liquidity provider stark key: %w", err)
+	}
+	return newSignableConditionalTransfer(
+		networkId,
+		positionId,
+		req.LpPositionId,
+		receiverPublicKey,
+		FactToCondition(common.HexToAddress(factRegistryAddress), fact),
+		req.DebitAmount,
+		req.ClientID,
+		req.Expiration,
+	)
+}
+
+func newSignableConditionalTransfer(
+	networkId int,
+	senderPositionId string,
+	receiverPositionId string,
+	receiverPublicKey *big.Int,
+	condition *big.Int,
+	humanAmount string,
+	clientID string,
+	expiration string,
+) (*SignableConditionalTransfer, error) {
+	collateralAssetID, err := collateralAssetID(networkId)
+	if err != nil {
+		return nil, err
+	}
+	senderPositionID, err := parseInt("sender position id", senderPositionId)
+	if err != nil {
+		return nil, err
+	}
+	receiverPositionID, err := parseInt("receiver position id", receiverPositionId)
+	if err != nil {
+		return nil, err
+	}
+	quantumsAmount, err := toQuantumsExact(humanAmount, constants.COLLATERAL_ASSET)
+	if err != nil {
+		return nil, err
+	}
+	expirationEpochHours, err := toEpochHours(expiration)
+	if err != nil {
+		return nil, err
+	}
+
+	return &SignableConditionalTransfer{
+		AssetIDCollateral:    collateralAssetID,
+		SenderPositionID:     senderPositionID,
+		ReceiverPositionID:   receiverPositionID,
+		ReceiverPublicKey:    receiverPublicKey,
+		Condition:            condition,
+		QuantumsAmount:       quantumsAmount,
+		Nonce:                NonceFromClientID(clientID),
+		ExpirationEpochHours: expirationEpochHours,
+	}, nil
+}
+
+// Hash computes the Pedersen hash of the conditional transfer, which is
+// the message that gets signed.
+func (t SignableConditionalTransfer) Hash() (*big.Int, error) {
+	if err := checkBitLength("condition", t.Condition, transferConditionBits); err != nil {
+		return nil, err
+	}
+	assetsHash, err := transferAssetsHash(t.AssetIDCollateral, t.ReceiverPublicKey)
+	if err != nil {
+		return nil, err
+	}
+	part1, err := PedersenHash(assetsHash, t.Condition)
+	if err != nil {
+		return nil, err
+	}
+	return transferHash(
+		part1,
+		conditionalTransferPrefix,
+		conditionalTransferPaddingBits,
+		t.SenderPositionID,
+		t.ReceiverPositionID,
+		t.QuantumsAmount,
+		t.Nonce,
+		t.ExpirationEpochHours,
+	)
+}
+
+// transferAssetsHash hashes the asset of a transfer with its receiver.
+// The transferred asset is always the collateral asset and fees are not
+// supported, so the fee asset is always zero.
+func transferAssetsHash(assetID, receiverPublicKey *big.Int) (*big.Int, error) {
+	if err := checkBitLength("collateral asset id", assetID, transferAssetIDBits); err != nil {
+		return nil, err
+	}
+	if err := checkBitLength("receiver public key", receiverPublicKey, transferReceiverPublicKeyBits); err != nil {
+		return nil, err
+	}
+	h, err := PedersenHash(assetID, big.NewInt(0))
+	if err != nil {
+		return nil, err
+	}
+	return PedersenHash(h, receiverPublicKey)
+}
+
+// transferHash packs the positions, amounts and expiration of a transfer
+// and hashes them together with part1.
+func transferHash(
+	part1 *big.Int,
+	prefix int64,
+	paddingBits uint,
+	senderPositionID *big.Int,
+	receiverPositionID *big.Int,
+	quantumsAmount *big.Int,
+	nonce *big.Int,
+	expirationEpochHours *big.Int,
+) (*big.Int, error) {
+	for _, f := range []struct {
+		name string
+		v    *big.Int
+		bits int
+	}{
+		{"sender position id", senderPositionID, transferPositionIDBits},
+		{"receiver position id", receiverPositionID, transferPositionIDBits},
+		{"quantums amount", quantumsAmount, transferQuantumsAmountBits},
+		{"nonce", nonce, nonceBitLength},
+		{"expiration epoch hours", expirationEpochHours, transferExpirationEpochHoursBits},
+	} {
+		if err := checkBitLength(f.name, f.v, f.bits); err != nil {
+			return nil, err
+		}
+	}
+
+	// The sender pays the (zero) fee.
+	part2 := new(big.Int).Set(senderPositionID)
+	part2.Lsh(part2, transferPositionIDBits).Add(part2, receiverPositionID)
+	part2.Lsh(part2, transferPositionIDBits).Add(part2, senderPositionID)
+	part2.Lsh(part2, nonceBitLength).Add(part2, nonce)
+
+	// The maximum fee amount is zero.
+	part3 := big.NewInt(prefix)
+	part3.Lsh(part3, transferQuantumsAmountBits).Add(part3, quantumsAmount)
+	part3.Lsh(part3, transferQuantumsAmountBits)
+	part3.Lsh(part3, transferExpirationEpochHoursBits).Add(part3, expirationEpochHours)
+	part3.Lsh(part3, paddingBits)
+
+	h, err := PedersenHash(part1, part2)
+	if err != nil {
+		return nil, err
+	}
+	return PedersenHash(h, part3)
+}
+
+// TransferERC20Fact computes the fact that gets registered in the fact
+// registry once humanAmount of the ERC-20 token at tokenAddress has been
+// sent to recipient on L1.
+func TransferERC20Fact(recipient common.Address, tokenDecimals int, humanAmount string, tokenAddress common.Address, salt *big.Int) ([]byte, error) {
+	amount, ok := new(big.Rat).SetString(humanAmount)
+	if !ok {
+		return nil, fmt.Errorf("invalid amount: %q", humanAmount)
+	}
+	scale := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(tokenDecimals)), nil)
+	amount.Mul(amount, new(big.Rat).SetInt(scale))
+	if !amount.IsInt() {
+		return nil, fmt.Errorf("amount %s has more than %d decimals", humanAmount, tokenDecimals)
+	}
+	return crypto.Keccak256(
+		recipient.Bytes(),
+		common.LeftPadBytes(amount.Num().Bytes(), 32),
+		tokenAddress.Bytes(),
+		common.LeftPadBytes(salt.Bytes(), 32),
+	), nil
+}
+
+// FactToCondition computes the condition signed as part of a conditional
+// transfer out of the fact and the registry it is expected in.
+func FactToCondition(factRegistryAddress common.Address, fact []byte) *big.Int {
+	condition := new(big.Int).SetBytes(crypto.Keccak256(factRegistryAddress.Bytes(), fact))
+	mask := new(big.Int).Lsh(big.NewInt(1), 250)
+	return condition.And(condition, mask.Sub(mask, big.NewInt(1)))
+}
@@ -0,0 +1,114 @@
+package starkex
+
+import (
+	"bytes"
+	"encoding/hex"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/tselementes/dydx-v3-go/constants"
+)
+
+func TestTransferERC20Fact(t *testing.T) {
+	// Vector of the fact tests of the dydx3 Python client.
+	want, _ := hex.DecodeString("34052387b5efb6132a42b244cff52a85a507ab319c414564d7a89207d4473672")
+
+	fact, err := TransferERC20Fact(
+		common.HexToAddress("0x1234567890123456789012345678901234567890"),
+		3,
+		"123.456",
+		common.HexToAddress("0xaAaAaAaaAaAaAaaAaAAAAAAAAaaaAaAaAaaAaaAa"),
+		hexInt(t, "0x1234567890abcdef"),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(fact, want) {
+		t.Errorf("fact = %x, want %x", fact, want)
+	}
+
+	if _, err := TransferERC20Fact(common.Address{}, 3, "123.4567", common.Address{}, big.NewInt(1)); err == nil {
+		t.Error("expected an error for an amount with too many decimals")
+	}
+	if _, err := TransferERC20Fact(common.Address{}, 3, "lots", common.Address{}, big.NewInt(1)); err == nil {
+		t.Error("expected an error for a malformed amount")
+	}
+}
+
+func TestFactToCondition(t *testing.T) {
+	// Vector of the fact tests of the dydx3 Python client.
+	registry := common.HexToAddress("0x12aa12aa12aa12aa12aa12aa12aa12aa12aa12aa")
+	fact := bytes.Repeat([]byte{0x12, 0xff}, 16)
+	want := hexInt(t, "0x17565043676f2dad6d156c0ce2af4dbf0522c87466efa8975837ec084852976")
+
+	got := FactToCondition(registry, fact)
+	if got.Cmp(want) != 0 {
+		t.Errorf("condition = %#x, want %#x", got, want)
+	}
+
+	// The condition is the keccak of the registry and fact, truncated to
+	// its 250 least significant bits.
+	full := new(big.Int).SetBytes(crypto.Keccak256(registry.Bytes(), fact))
+	if full.BitLen() <= 250 {
+		t.Fatal("vector does not exercise the mask")
+	}
+	if got.BitLen() > 250 {
+		t.Errorf("condition has %d bits, want at most 250", got.BitLen())
+	}
+	mask := new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 250), big.NewInt(1))
+	if got.Cmp(new(big.Int).And(full, mask)) != 0 {
+		t.Errorf("condition = %#x, want the low 250 bits of %#x", got, full)
+	}
+}
+
+func TestConditionalTransferHash(t *testing.T) {
+	// The expected hash follows the StarkEx conditional transfer layout
+	// field by field. It only differs from a transfer in its prefix and
+	// in the condition being hashed into the first part.
+	var (
+		assetID     = hexInt(t, constants.COLLATERAL_ASSET_ID_BY_NETWORK_ID[constants.NETWORK_ID_ROPSTEN])
+		feeAssetID  = big.NewInt(0)
+		receiverKey = hexInt(t, "0x05135ef87716b0faecec3ba672d145a6daad0aa46437c365d490022115aba674")
+		condition   = hexInt(t, "0x17565043676f2dad6d156c0ce2af4dbf0522c87466efa8975837ec084852976")
+		sender      = big.NewInt(12345)
+		receiver    = big.NewInt(67890)
+		feePosition = sender
+		nonce       = NonceFromClientID("This is an ID that the client came up with to describe this transfer")
+		amount      = big.NewInt(49478023)
+		maxFee      = big.NewInt(0)
+		expiration  = big.NewInt(444533)
+	)
+	part1 := mustPedersen(t, mustPedersen(t, mustPedersen(t, assetID, feeAssetID), receiverKey), condition)
+	part2 := or(shl(sender, 64+64+32), shl(receiver, 64+32), shl(feePosition, 32), nonce)
+	part3 := or(shl(big.NewInt(5), 64+64+32+81), shl(amount, 64+32+81), shl(maxFee, 32+81), shl(expiration, 81))
+	want := mustPedersen(t, mustPedersen(t, part1, part2), part3)
+
+	ct, err := newSignableConditionalTransfer(
+		constants.NETWORK_ID_ROPSTEN,
+		mockPositionID,
+		"67890",
+		hexInt(t, "0x05135ef87716b0faecec3ba672d145a6daad0aa46437c365d490022115aba674"),
+		hexInt(t, "0x17565043676f2dad6d156c0ce2af4dbf0522c87466efa8975837ec084852976"),
+		"49.478023",
+		"This is an ID that the client came up with to describe this transfer",
+		"2020-09-17T04:15:55.028Z",
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := ct.Hash()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.Cmp(want) != 0 {
+		t.Errorf("hash = %#x, want %#x", got, want)
+	}
+
+	ct.Condition = new(big.Int).Lsh(big.NewInt(1), 251)
+	if _, err := ct.Hash(); err == nil {
+		t.Error("expected an error for a condition wider than 251 bits")
+	}
+}
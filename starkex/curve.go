@@ -0,0 +1,174 @@
+package starkex
+
+import (
+	_ "embed"
+	"encoding/json"
+	"fmt"
+	"math/big"
+)
+
+const (
+	// Number of bits of an element hashed by a single Pedersen
+	// hash round, i.e. the bit length of FieldPrime.
+	nElementBitsHash = 252
+	// Maximum bit length of a message hash, and of the r and w
+	// components of a signature.
+	nElementBitsECDSA = 251
+)
+
+// pedersen_params.json is published by StarkWare and contains the STARK
+// curve definition as well as the constant points used by the Pedersen hash.
+//
+//go:embed pedersen_params.json
+var pedersenParamsJSON []byte
+
+type pedersenParams struct {
+	FieldPrime     *big.Int      `json:"FIELD_PRIME"`
+	FieldGen       *big.Int      `json:"FIELD_GEN"`
+	EcOrder        *big.Int      `json:"EC_ORDER"`
+	Alpha          *big.Int      `json:"ALPHA"`
+	Beta           *big.Int      `json:"BETA"`
+	ConstantPoints [][2]*big.Int `json:"CONSTANT_POINTS"`
+}
+
+var (
+	// FieldPrime is the prime of the field the STARK curve is defined over.
+	FieldPrime *big.Int
+	// EcOrder is the order of the STARK curve generator.
+	EcOrder *big.Int
+	// Alpha and Beta are the coefficients of the STARK curve
+	// y^2 = x^3 + Alpha*x + Beta.
+	Alpha *big.Int
+	Beta  *big.Int
+	// EcGen is the generator used for STARK key derivation and signatures.
+	EcGen Point
+
+	// shiftPoint is the starting point of every Pedersen hash.
+	shiftPoint Point
+	// constantPoints holds the Pedersen hash points, starting right
+	// after the shift point and the generator.
+	constantPoints []Point
+)
+
+func init() {
+	params := pedersenParams{}
+	if err := json.Unmarshal(pedersenParamsJSON, &params); err != nil {
+		panic(fmt.Sprintf("cannot parse pedersen params: %v", err))
+	}
+	if len(params.ConstantPoints) < 2 {
+		panic(fmt.Sprintf("unexpected number of constant points: %d", len(params.ConstantPoints)))
+	}
+
+	FieldPrime = params.FieldPrime
+	EcOrder = params.EcOrder
+	Alpha = params.Alpha
+	Beta = params.Beta
+
+	points := make([]Point, len(params.ConstantPoints))
+	for i, p := range params.ConstantPoints {
+		points[i] = Point{X: p[0], Y: p[1]}
+	}
+	shiftPoint = points[0]
+	EcGen = points[1]
+	constantPoints = points[2:]
+}
+
+// Point is an affine point on the STARK curve. A Point with a nil X
+// coordinate is the point at infinity.
+type Point struct {
+	X *big.Int
+	Y *big.Int
+}
+
+func (p Point) isInfinity() bool {
+	return p.X == nil
+}
+
+// IsOnCurve reports whether p satisfies the STARK curve equation.
+func (p Point) IsOnCurve() bool {
+	if p.isInfinity() || p.Y == nil {
+		return false
+	}
+	lhs := new(big.Int).Mul(p.Y, p.Y)
+	lhs.Mod(lhs, FieldPrime)
+
+	rhs := new(big.Int).Exp(p.X, big.NewInt(3), FieldPrime)
+	rhs.Add(rhs, new(big.Int).Mul(Alpha, p.X))
+	rhs.Add(rhs, Beta)
+	rhs.Mod(rhs, FieldPrime)
+
+	return lhs.Cmp(rhs) == 0
+}
+
+// divMod returns n / m modulo p.
+func divMod(n, m, p *big.Int) *big.Int {
+	inv := new(big.Int).ModInverse(m, p)
+	res := new(big.Int).Mul(n, inv)
+	return res.Mod(res, p)
+}
+
+func ecAdd(p, q Point) Point {
+	if p.isInfinity() {
+		return q
+	}
+	if q.isInfinity() {
+		return p
+	}
+	if p.X.Cmp(q.X) == 0 {
+		if p.Y.Cmp(q.Y) == 0 {
+			return ecDouble(p)
+		}
+		return Point{}
+	}
+
+	m := divMod(
+		new(big.Int).Sub(p.Y, q.Y),
+		new(big.Int).Sub(p.X, q.X),
+		FieldPrime,
+	)
+	x := new(big.Int).Mul(m, m)
+	x.Sub(x, p.X)
+	x.Sub(x, q.X)
+	x.Mod(x, FieldPrime)
+
+	y := new(big.Int).Sub(p.X, x)
+	y.Mul(y, m)
+	y.Sub(y, p.Y)
+	y.Mod(y, FieldPrime)
+
+	return Point{X: x, Y: y}
+}
+
+func ecDouble(p Point) Point {
+	if p.isInfinity() || p.Y.Sign() == 0 {
+		return Point{}
+	}
+
+	num := new(big.Int).Mul(p.X, p.X)
+	num.Mul(num, big.NewInt(3))
+	num.Add(num, Alpha)
+	m := divMod(num, new(big.Int).Lsh(p.Y, 1), FieldPrime)
+
+	x := new(big.Int).Mul(m, m)
+	x.Sub(x, new(big.Int).Lsh(p.X, 1))
+	x.Mod(x, FieldPrime)
+
+	y := new(big.Int).Sub(p.X, x)
+	y.Mul(y, m)
+	y.Sub(y, p.Y)
+	y.Mod(y, FieldPrime)
+
+	return Point{X: x, Y: y}
+}
+
+// ecMult computes m * p using double-and-add.
+func ecMult(m *big.Int, p Point) Point {
+	res := Point{}
+	for i := m.BitLen() - 1; i >= 0; i-- {
+		res = ecDouble(res)
+		if m.Bit(i) == 1 {
+			res = ecAdd(res, p)
+		}
+	}
+	return res
+}
@@ -0,0 +1,125 @@
+package starkex
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"math/big"
+	"strconv"
+	"time"
+
+	"github.com/tselementes/dydx-v3-go/constants"
+)
+
+const nonceBitLength = 32
+
+// NonceFromClientID derives the nonce signed as part of a StarkEx message
+// from the client id of the corresponding request.
+func NonceFromClientID(clientID string) *big.Int {
+	digest := sha256.Sum256([]byte(clientID))
+	nonce := new(big.Int).SetBytes(digest[:])
+	return nonce.Mod(nonce, new(big.Int).Lsh(big.NewInt(1), nonceBitLength))
+}
+
+// toQuantumsExact converts a human readable amount of asset to quantums and
+// fails if the amount is not a multiple of the quantum size.
+func toQuantumsExact(humanAmount, asset string) (*big.Int, error) {
+	amount, ok := new(big.Rat).SetString(humanAmount)
+	if !ok {
+		return nil, fmt.Errorf("invalid amount: %q", humanAmount)
+	}
+	quantums, err := toQuantums(amount, asset)
+	if err != nil {
+		return nil, err
+	}
+	if !quantums.IsInt() {
+		return nil, fmt.Errorf("amount %s is not a multiple of the quantum size of %s", humanAmount, asset)
+	}
+	return new(big.Int).Set(quantums.Num()), nil
+}
+
+func toQuantumsRoundDown(amount *big.Rat, asset string) (*big.Int, error) {
+	quantums, err := toQuantums(amount, asset)
+	if err != nil {
+		return nil, err
+	}
+	return floor(quantums), nil
+}
+
+func toQuantumsRoundUp(amount *big.Rat, asset string) (*big.Int, error) {
+	quantums, err := toQuantums(amount, asset)
+	if err != nil {
+		return nil, err
+	}
+	return ceil(quantums), nil
+}
+
+func toQuantums(amount *big.Rat, asset string) (*big.Rat, error) {
+	resolution, ok := constants.ASSET_RESOLUTION[asset]
+	if !ok {
+		return nil, fmt.Errorf("unknown asset resolution: %s", asset)
+	}
+	r, ok := new(big.Rat).SetString(resolution)
+	if !ok {
+		return nil, fmt.Errorf("invalid asset resolution for %s: %q", asset, resolution)
+	}
+	return r.Mul(r, amount), nil
+}
+
+func floor(r *big.Rat) *big.Int {
+	// Euclidean division rounds towards negative infinity
+	// since the denominator is always positive.
+	return new(big.Int).Div(r.Num(), r.Denom())
+}
+
+func ceil(r *big.Rat) *big.Int {
+	neg := new(big.Rat).Neg(r)
+	return floor(neg).Neg(floor(neg))
+}
+
+// toEpochHours converts an ISO 8601 timestamp to hours since the Unix
+// epoch, rounding up.
+func toEpochHours(iso string) (*big.Int, error) {
+	t, err := time.Parse(time.RFC3339, iso)
+	if err != nil {
+		return nil, fmt.Errorf("invalid expiration: %w", err)
+	}
+	// time.Duration cannot hold the time since the epoch of dates after
+	// 2262, so whole seconds and the fraction are handled separately.
+	hours, seconds := new(big.Int).DivMod(big.NewInt(t.Unix()), big.NewInt(3600), new(big.Int))
+	if seconds.Sign() > 0 || t.Nanosecond() > 0 {
+		hours.Add(hours, big.NewInt(1))
+	}
+	return hours, nil
+}
+
+func parseInt(name, s string) (*big.Int, error) {
+	if _, err := strconv.ParseUint(s, 10, 64); err != nil {
+		return nil, fmt.Errorf("invalid %s: %w", name, err)
+	}
+	v, _ := new(big.Int).SetString(s, 10)
+	return v, nil
+}
+
+func parseAssetID(id string) (*big.Int, error) {
+	v, err := parseHex(id)
+	if err != nil {
+		return nil, fmt.Errorf("invalid asset id: %w", err)
+	}
+	return v, nil
+}
+
+func collateralAssetID(networkId int) (*big.Int, error) {
+	id, ok := constants.COLLATERAL_ASSET_ID_BY_NETWORK_ID[networkId]
+	if !ok {
+		return nil, fmt.Errorf("unknown network id: %d", networkId)
+	}
+	return parseAssetID(id)
+}
+
+// checkBitLength verifies v can be packed into a field of the given size.
+func checkBitLength(name string, v *big.Int, bits int) error {
+	if v.Sign() < 0 || v.BitLen() > bits {
+		return fmt.Errorf("%s does not fit in %d bits: %s", name, bits, v)
+	}
+	return nil
+}
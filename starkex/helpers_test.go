@@ -0,0 +1,41 @@
+package starkex
+
+import (
+	"math/big"
+	"testing"
+)
+
+func TestToEpochHours(t *testing.T) {
+	tests := []struct {
+		iso  string
+		want string
+	}{
+		{"1970-01-01T00:00:00Z", "0"},
+		{"1970-01-01T00:00:00.000000001Z", "1"},
+		{"1970-01-01T01:00:00Z", "1"},
+		{"1970-01-01T01:00:01Z", "2"},
+		{"2020-09-17T04:00:00Z", "444532"},
+		{"2020-09-17T04:15:55.028Z", "444533"},
+		{"2020-09-17T04:15:55.028+02:00", "444531"},
+		{"1969-12-31T23:00:00Z", "-1"},
+		{"1969-12-31T23:30:00Z", "0"},
+		// Past the range of time.Duration since the epoch.
+		{"2300-01-01T00:00:00Z", "2892720"},
+		{"9999-12-31T23:59:59.5Z", "70389528"},
+	}
+	for _, tt := range tests {
+		got, err := toEpochHours(tt.iso)
+		if err != nil {
+			t.Errorf("toEpochHours(%q): %v", tt.iso, err)
+			continue
+		}
+		want, _ := new(big.Int).SetString(tt.want, 10)
+		if got.Cmp(want) != 0 {
+			t.Errorf("toEpochHours(%q) = %s, want %s", tt.iso, got, want)
+		}
+	}
+
+	if _, err := toEpochHours("2020-09-17"); err == nil {
+		t.Error("expected an error for a timestamp without a time")
+	}
+}
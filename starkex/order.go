@@ -0,0 +1,180 @@
+package starkex
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/tselementes/dydx-v3-go/constants"
+	"github.com/tselementes/dydx-v3-go/types"
+)
+
+const (
+	orderPrefix      = 3
+	orderPaddingBits = 17
+
+	orderAssetIDSyntheticBits     = 128
+	orderAssetIDCollateralBits    = 250
+	orderQuantumsAmountBits       = 64
+	orderPositionIDBits           = 64
+	orderExpirationEpochHoursBits = 32
+
+	// The STARK signature of an order expires a week later than the
+	// order itself, so the order can be canceled in the meantime.
+	orderSignatureExpirationBufferHours = 24 * 7
+	// The limit fee is constrained to six decimals of precision.
+	limitFeePrecision = 1000000
+)
+
+// SignableOrder is a StarkEx limit order with fees.
+type SignableOrder struct {
+	AssetIDSynthetic         *big.Int
+	AssetIDCollateral        *big.Int
+	AssetIDFee               *big.Int
+	IsBuyingSynthetic        bool
+	QuantumsAmountSynthetic  *big.Int
+	QuantumsAmountCollateral *big.Int
+	QuantumsAmountFee        *big.Int
+	Nonce                    *big.Int
+	PositionID               *big.Int
+	ExpirationEpochHours     *big.Int
+}
+
+// NewSignableOrder converts an order request placed by positionId into
+// the StarkEx order that needs to be signed.
+func NewSignableOrder(networkId int, positionId string, req *types.OrderRequest) (*SignableOrder, error) {
+	syntheticAsset, ok := constants.SYNTHETIC_ASSET_MAP[req.Market]
+	if !ok {
+		return nil, fmt.Errorf("unknown market: %s", req.Market)
+	}
+	syntheticAssetID, err := parseAssetID(constants.SYNTHETIC_ASSET_ID_MAP[syntheticAsset])
+	if err != nil {
+		return nil, err
+	}
+	collateralAssetID, err := collateralAssetID(networkId)
+	if err != nil {
+		return nil, err
+	}
+
+	var isBuyingSynthetic bool
+	switch req.Side {
+	case constants.ORDER_SIDE_BUY:
+		isBuyingSynthetic = true
+	case constants.ORDER_SIDE_SELL:
+	default:
+		return nil, fmt.Errorf("invalid order side: %s", req.Side)
+	}
+
+	quantumsAmountSynthetic, err := toQuantumsExact(req.Size, syntheticAsset)
+	if err != nil {
+		return nil, err
+	}
+
+	size, ok := new(big.Rat).SetString(req.Size)
+	if !ok {
+		return nil, fmt.Errorf("invalid size: %q", req.Size)
+	}
+	price, ok := new(big.Rat).SetString(req.Price)
+	if !ok {
+		return nil, fmt.Errorf("invalid price: %q", req.Price)
+	}
+	// The collateral amount is rounded against the party placing the order.
+	cost := new(big.Rat).Mul(size, price)
+	var quantumsAmountCollateral *big.Int
+	if isBuyingSynthetic {
+		quantumsAmountCollateral, err = toQuantumsRoundUp(cost, constants.COLLATERAL_ASSET)
+	} else {
+		quantumsAmountCollateral, err = toQuantumsRoundDown(cost, constants.COLLATERAL_ASSET)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	// The limit fee is a fraction, e.g. 0.01 is a 1% fee, and it is always
+	// paid in the collateral asset. The final fee amount is rounded up.
+	limitFee, ok := new(big.Rat).SetString(req.LimitFee)
+	if !ok {
+		return nil, fmt.Errorf("invalid limit fee: %q", req.LimitFee)
+	}
+	precision := big.NewRat(limitFeePrecision, 1)
+	limitFee = new(big.Rat).SetFrac(floor(limitFee.Mul(limitFee, precision)), precision.Num())
+	quantumsAmountFee := ceil(limitFee.Mul(limitFee, new(big.Rat).SetInt(quantumsAmountCollateral)))
+
+	positionID, err := parseInt("position id", positionId)
+	if err != nil {
+		return nil, err
+	}
+	expirationEpochHours, err := toEpochHours(req.Expiration)
+	if err != nil {
+		return nil, err
+	}
+	expirationEpochHours.Add(expirationEpochHours, big.NewInt(orderSignatureExpirationBufferHours))
+
+	return &SignableOrder{
+		AssetIDSynthetic:         syntheticAssetID,
+		AssetIDCollateral:        collateralAssetID,
+		AssetIDFee:               collateralAssetID,
+		IsBuyingSynthetic:        isBuyingSynthetic,
+		QuantumsAmountSynthetic:  quantumsAmountSynthetic,
+		QuantumsAmountCollateral: quantumsAmountCollateral,
+		QuantumsAmountFee:        quantumsAmountFee,
+		Nonce:                    NonceFromClientID(req.ClientID),
+		PositionID:               positionID,
+		ExpirationEpochHours:     expirationEpochHours,
+	}, nil
+}
+
+// Hash computes the Pedersen hash of the order, which is the message that
+// gets signed.
+func (o SignableOrder) Hash() (*big.Int, error) {
+	for _, f := range []struct {
+		name string
+		v    *big.Int
+		bits int
+	}{
+		{"synthetic asset id", o.AssetIDSynthetic, orderAssetIDSyntheticBits},
+		{"collateral asset id", o.AssetIDCollateral, orderAssetIDCollateralBits},
+		{"fee asset id", o.AssetIDFee, orderAssetIDCollateralBits},
+		{"synthetic quantums amount", o.QuantumsAmountSynthetic, orderQuantumsAmountBits},
+		{"collateral quantums amount", o.QuantumsAmountCollateral, orderQuantumsAmountBits},
+		{"fee quantums amount", o.QuantumsAmountFee, orderQuantumsAmountBits},
+		{"nonce", o.Nonce, nonceBitLength},
+		{"position id", o.PositionID, orderPositionIDBits},
+		{"expiration epoch hours", o.ExpirationEpochHours, orderExpirationEpochHoursBits},
+	} {
+		if err := checkBitLength(f.name, f.v, f.bits); err != nil {
+			return nil, err
+		}
+	}
+
+	assetIDSell, assetIDBuy := o.AssetIDSynthetic, o.AssetIDCollateral
+	quantumsAmountSell, quantumsAmountBuy := o.QuantumsAmountSynthetic, o.QuantumsAmountCollateral
+	if o.IsBuyingSynthetic {
+		assetIDSell, assetIDBuy = o.AssetIDCollateral, o.AssetIDSynthetic
+		quantumsAmountSell, quantumsAmountBuy = o.QuantumsAmountCollateral, o.QuantumsAmountSynthetic
+	}
+
+	part1 := new(big.Int).Set(quantumsAmountSell)
+	part1.Lsh(part1, orderQuantumsAmountBits).Add(part1, quantumsAmountBuy)
+	part1.Lsh(part1, orderQuantumsAmountBits).Add(part1, o.QuantumsAmountFee)
+	part1.Lsh(part1, nonceBitLength).Add(part1, o.Nonce)
+
+	part2 := big.NewInt(orderPrefix)
+	for i := 0; i < 3; i++ {
+		part2.Lsh(part2, orderPositionIDBits).Add(part2, o.PositionID)
+	}
+	part2.Lsh(part2, orderExpirationEpochHoursBits).Add(part2, o.ExpirationEpochHours)
+	part2.Lsh(part2, orderPaddingBits)
+
+	assetsHash, err := PedersenHash(assetIDSell, assetIDBuy)
+	if err != nil {
+		return nil, err
+	}
+	if assetsHash, err = PedersenHash(assetsHash, o.AssetIDFee); err != nil {
+		return nil, err
+	}
+	h, err := PedersenHash(assetsHash, part1)
+	if err != nil {
+		return nil, err
+	}
+	return PedersenHash(h, part2)
+}
@@ -0,0 +1,147 @@
+package starkex
+
+import (
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/tselementes/dydx-v3-go/constants"
+	"github.com/tselementes/dydx-v3-go/types"
+)
+
+// mockOrder mirrors ORDER_PARAMS of the dydx3 Python client test suite.
+func mockOrder() *types.OrderRequest {
+	return &types.OrderRequest{
+		Market:     constants.MARKET_ETH_USD,
+		Side:       constants.ORDER_SIDE_BUY,
+		Type:       types.OrderTypeLimit,
+		Size:       "145.0005",
+		Price:      "350.00067",
+		LimitFee:   "0.125",
+		Expiration: "2020-09-17T04:15:55.028Z",
+		ClientID:   "This is an ID that the client came up with to describe this order",
+	}
+}
+
+const mockPositionID = "12345"
+
+func TestSignOrderKnownAnswer(t *testing.T) {
+	// MOCK_SIGNATURE of the order tests of the dydx3 Python client.
+	const want = "00cecbe513ecdbf782cd02b2a5efb03e58d5f63d15f2b840e9bc0029af04e8dd0090b822b16f50b2120e4ea9852b340f7936ff6069d02acca02f2ed03029ace5"
+
+	o, err := NewSignableOrder(constants.NETWORK_ID_ROPSTEN, mockPositionID, mockOrder())
+	if err != nil {
+		t.Fatal(err)
+	}
+	h, err := o.Hash()
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := mockSigner(t).Sign(h)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got != want {
+		t.Errorf("signature = %s, want %s", got, want)
+	}
+}
+
+func TestNewSignableOrder(t *testing.T) {
+	// 145.0005 * 350.00067 = 50750.272150335 USDC.
+	// 1600316155.028s is 444532.27 hours after the epoch, rounded up to
+	// 444533, plus the 168 hours of signature expiration buffer.
+	tests := []struct {
+		name       string
+		change     func(*types.OrderRequest)
+		synthetic  int64
+		collateral int64
+		fee        int64
+		expiration int64
+	}{
+		{
+			name:       "buy rounds the collateral up",
+			change:     func(*types.OrderRequest) {},
+			synthetic:  145000500000,
+			collateral: 50750272151,
+			fee:        6343784019,
+			expiration: 444701,
+		},
+		{
+			name:       "sell rounds the collateral down",
+			change:     func(o *types.OrderRequest) { o.Side = constants.ORDER_SIDE_SELL },
+			synthetic:  145000500000,
+			collateral: 50750272150,
+			fee:        6343784019,
+			expiration: 444701,
+		},
+		{
+			name:       "limit fee is truncated to six decimals",
+			change:     func(o *types.OrderRequest) { o.LimitFee = "0.0000019" },
+			synthetic:  145000500000,
+			collateral: 50750272151,
+			fee:        50751,
+			expiration: 444701,
+		},
+		{
+			name:       "expiration on the hour is not rounded",
+			change:     func(o *types.OrderRequest) { o.Expiration = "2020-09-17T04:00:00Z" },
+			synthetic:  145000500000,
+			collateral: 50750272151,
+			fee:        6343784019,
+			expiration: 444532 + 168,
+		},
+	}
+	for _, tt := range tests {
+		req := mockOrder()
+		tt.change(req)
+		o, err := NewSignableOrder(constants.NETWORK_ID_ROPSTEN, mockPositionID, req)
+		if err != nil {
+			t.Fatalf("%s: %v", tt.name, err)
+		}
+		for _, f := range []struct {
+			field string
+			got   *big.Int
+			want  int64
+		}{
+			{"synthetic quantums", o.QuantumsAmountSynthetic, tt.synthetic},
+			{"collateral quantums", o.QuantumsAmountCollateral, tt.collateral},
+			{"fee quantums", o.QuantumsAmountFee, tt.fee},
+			{"expiration epoch hours", o.ExpirationEpochHours, tt.expiration},
+		} {
+			if f.got.Cmp(big.NewInt(f.want)) != 0 {
+				t.Errorf("%s: %s = %s, want %d", tt.name, f.field, f.got, f.want)
+			}
+		}
+		if _, err := o.Hash(); err != nil {
+			t.Errorf("%s: %v", tt.name, err)
+		}
+	}
+}
+
+func TestNewSignableOrderErrors(t *testing.T) {
+	tests := []struct {
+		name       string
+		networkId  int
+		positionId string
+		change     func(*types.OrderRequest)
+		wantErr    string
+	}{
+		{"unknown market", constants.NETWORK_ID_ROPSTEN, mockPositionID, func(o *types.OrderRequest) { o.Market = "DOGE-EUR" }, "unknown market"},
+		{"inexact size", constants.NETWORK_ID_ROPSTEN, mockPositionID, func(o *types.OrderRequest) { o.Size = "145.0000000001" }, "quantum size"},
+		{"malformed size", constants.NETWORK_ID_ROPSTEN, mockPositionID, func(o *types.OrderRequest) { o.Size = "lots" }, "invalid amount"},
+		{"malformed price", constants.NETWORK_ID_ROPSTEN, mockPositionID, func(o *types.OrderRequest) { o.Price = "cheap" }, "invalid price"},
+		{"malformed limit fee", constants.NETWORK_ID_ROPSTEN, mockPositionID, func(o *types.OrderRequest) { o.LimitFee = "" }, "invalid limit fee"},
+		{"malformed expiration", constants.NETWORK_ID_ROPSTEN, mockPositionID, func(o *types.OrderRequest) { o.Expiration = "tomorrow" }, "invalid expiration"},
+		{"invalid side", constants.NETWORK_ID_ROPSTEN, mockPositionID, func(o *types.OrderRequest) { o.Side = "HOLD" }, "invalid order side"},
+		{"invalid position id", constants.NETWORK_ID_ROPSTEN, "-1", func(*types.OrderRequest) {}, "invalid position id"},
+		{"unknown network", 42, mockPositionID, func(*types.OrderRequest) {}, "unknown network id"},
+	}
+	for _, tt := range tests {
+		req := mockOrder()
+		tt.change(req)
+		_, err := NewSignableOrder(tt.networkId, tt.positionId, req)
+		if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+			t.Errorf("%s: got error %v, want %q", tt.name, err, tt.wantErr)
+		}
+	}
+}
@@ -0,0 +1,33 @@
+package starkex
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+)
+
+// PedersenHash computes the StarkWare Pedersen hash of the provided field
+// elements. Every element must be in the range [0, FieldPrime). The constant
+// points published by StarkWare allow hashing of up to two elements.
+func PedersenHash(elements ...*big.Int) (*big.Int, error) {
+	if len(elements)*nElementBitsHash > len(constantPoints) {
+		return nil, fmt.Errorf("cannot hash %d elements: at most %d are supported", len(elements), len(constantPoints)/nElementBitsHash)
+	}
+
+	point := shiftPoint
+	for i, x := range elements {
+		if x.Sign() < 0 || x.Cmp(FieldPrime) >= 0 {
+			return nil, fmt.Errorf("element %d is not a field element: %s", i, x)
+		}
+		points := constantPoints[i*nElementBitsHash : (i+1)*nElementBitsHash]
+		for j, pt := range points {
+			if point.X.Cmp(pt.X) == 0 {
+				return nil, errors.New("unhashable input")
+			}
+			if x.Bit(j) == 1 {
+				point = ecAdd(point, pt)
+			}
+		}
+	}
+	return new(big.Int).Set(point.X), nil
+}
@@ -0,0 +1,103 @@
+package starkex
+
+import (
+	"math/big"
+	"testing"
+)
+
+func hexInt(t *testing.T, s string) *big.Int {
+	t.Helper()
+	v, err := parseHex(s)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return v
+}
+
+func TestPedersenHash(t *testing.T) {
+	// Test vectors published by StarkWare.
+	tests := []struct {
+		a, b string
+		want string
+	}{
+		{
+			a:    "0x3d937c035c878245caf64531a5756109c53068da139362728feb561405371cb",
+			b:    "0x208a0a10250e382e1e4bbe2880906c2791bf6275695e02fbbc6aeff9cd8b31a",
+			want: "0x30e480bed5fe53fa909cc0f8c4d99b8f9f2c016be4c41e13a4848797979c662",
+		},
+		{
+			a:    "0x58f580910a6ca59b28927c08fe6c43e2e303ca384badc365795fc645d479d45",
+			b:    "0x78734f65a067be9bdb39de18434d71e79f7b6466a4b66bbd979ab9e7515fe0b",
+			want: "0x68cc0b76cddd1dd4ed2301ada9b7c872b23875d5ff837b3a87993e0d9996b87",
+		},
+		{
+			a:    "0x12773",
+			b:    "0x872362",
+			want: "0x5ed2703dfdb505c587700ce2ebfcab5b3515cd7e6114817e6026ec9d4b364ca",
+		},
+	}
+	for _, tt := range tests {
+		got, err := PedersenHash(hexInt(t, tt.a), hexInt(t, tt.b))
+		if err != nil {
+			t.Fatalf("PedersenHash(%s, %s): %v", tt.a, tt.b, err)
+		}
+		if want := hexInt(t, tt.want); got.Cmp(want) != 0 {
+			t.Errorf("PedersenHash(%s, %s) = %#x, want %#x", tt.a, tt.b, got, want)
+		}
+	}
+}
+
+func TestPedersenHashErrors(t *testing.T) {
+	one := big.NewInt(1)
+	tests := []struct {
+		name     string
+		elements []*big.Int
+	}{
+		{"too many elements", []*big.Int{one, one, one}},
+		{"negative element", []*big.Int{one, big.NewInt(-1)}},
+		{"element out of the field", []*big.Int{new(big.Int).Set(FieldPrime), one}},
+	}
+	for _, tt := range tests {
+		if _, err := PedersenHash(tt.elements...); err == nil {
+			t.Errorf("%s: expected an error", tt.name)
+		}
+	}
+}
+
+func TestCurvePoints(t *testing.T) {
+	if !EcGen.IsOnCurve() {
+		t.Error("generator is not on the curve")
+	}
+	if !shiftPoint.IsOnCurve() {
+		t.Error("shift point is not on the curve")
+	}
+	for i, p := range constantPoints {
+		if !p.IsOnCurve() {
+			t.Fatalf("constant point %d is not on the curve", i)
+		}
+	}
+}
+
+func TestCurveArithmetic(t *testing.T) {
+	equal := func(p, q Point) bool {
+		return p.X.Cmp(q.X) == 0 && p.Y.Cmp(q.Y) == 0
+	}
+	double := ecDouble(EcGen)
+	if !equal(double, ecMult(big.NewInt(2), EcGen)) {
+		t.Error("G+G and 2G disagree")
+	}
+	triple := ecAdd(double, EcGen)
+	if !triple.IsOnCurve() {
+		t.Error("3G is not on the curve")
+	}
+	if !equal(triple, ecMult(big.NewInt(3), EcGen)) {
+		t.Error("2G+G and 3G disagree")
+	}
+	neg := Point{X: EcGen.X, Y: new(big.Int).Sub(FieldPrime, EcGen.Y)}
+	if !ecAdd(EcGen, neg).isInfinity() {
+		t.Error("G-G is not the point at infinity")
+	}
+	if !ecMult(EcOrder, EcGen).isInfinity() {
+		t.Error("nG is not the point at infinity")
+	}
+}
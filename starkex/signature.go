@@ -0,0 +1,170 @@
+package starkex
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"math/big"
+)
+
+// PrivateKeyToPublicKey returns the public key matching privateKey.
+func PrivateKeyToPublicKey(privateKey *big.Int) Point {
+	return ecMult(privateKey, EcGen)
+}
+
+// Sign signs msgHash with privateKey. The nonce is derived deterministically
+// from the message hash and the private key as described in RFC 6979, which
+// makes the produced signature identical to the one of StarkWare's reference
+// implementation.
+func Sign(msgHash, privateKey *big.Int) (r, s *big.Int, err error) {
+	if msgHash.Sign() < 0 || msgHash.BitLen() > nElementBitsECDSA {
+		return nil, nil, fmt.Errorf("message hash is out of range: %s", msgHash)
+	}
+	if privateKey.Sign() <= 0 || privateKey.Cmp(EcOrder) >= 0 {
+		return nil, nil, errors.New("private key is out of range")
+	}
+
+	var seed *big.Int
+	for {
+		k := generateKRFC6979(msgHash, privateKey, seed)
+		// Update seed for the next iteration in case the value of k is bad.
+		if seed == nil {
+			seed = big.NewInt(1)
+		} else {
+			seed = new(big.Int).Add(seed, big.NewInt(1))
+		}
+
+		// Unlike classic ECDSA, r is the x coordinate itself and not
+		// its value modulo EcOrder.
+		r = ecMult(k, EcGen).X
+		if r.Sign() == 0 || r.BitLen() > nElementBitsECDSA {
+			continue
+		}
+
+		sum := new(big.Int).Mul(r, privateKey)
+		sum.Add(sum, msgHash)
+		sum.Mod(sum, EcOrder)
+		if sum.Sign() == 0 {
+			continue
+		}
+
+		w := divMod(k, sum, EcOrder)
+		if w.Sign() == 0 || w.BitLen() > nElementBitsECDSA {
+			continue
+		}
+		return r, new(big.Int).ModInverse(w, EcOrder), nil
+	}
+}
+
+// Verify reports whether (r, s) is a valid signature of msgHash for
+// publicKey.
+func Verify(msgHash, r, s *big.Int, publicKey Point) bool {
+	if s.Sign() <= 0 || s.Cmp(EcOrder) >= 0 {
+		return false
+	}
+	w := new(big.Int).ModInverse(s, EcOrder)
+	if r.Sign() <= 0 || r.BitLen() > nElementBitsECDSA {
+		return false
+	}
+	if w.Sign() <= 0 || w.BitLen() > nElementBitsECDSA {
+		return false
+	}
+	if msgHash.Sign() < 0 || msgHash.BitLen() > nElementBitsECDSA {
+		return false
+	}
+	if !publicKey.IsOnCurve() {
+		return false
+	}
+
+	// Classic ECDSA computes (w*msgHash)*EcGen + (w*r)*publicKey, which
+	// is equivalent to the following.
+	zG := ecMult(msgHash, EcGen)
+	rQ := ecMult(r, publicKey)
+	wB := ecMult(w, ecAdd(zG, rQ))
+	return !wB.isInfinity() && wB.X.Cmp(r) == 0
+}
+
+// generateKRFC6979 derives the signing nonce for msgHash. seed is used as
+// additional entropy and may be nil.
+func generateKRFC6979(msgHash, privateKey, seed *big.Int) *big.Int {
+	// Pad the message hash when it is one nibble short, for consistency
+	// with the elliptic.js library.
+	if bitLen := msgHash.BitLen(); bitLen >= 248 && bitLen%8 >= 1 && bitLen%8 <= 4 {
+		msgHash = new(big.Int).Lsh(msgHash, 4)
+	}
+
+	var extraEntropy []byte
+	if seed != nil {
+		extraEntropy = seed.Bytes()
+	}
+	return rfc6979(EcOrder, privateKey, msgHash.Bytes(), extraEntropy)
+}
+
+// rfc6979 implements the nonce generation of RFC 6979 section 3.2 using
+// HMAC-SHA256, with the additional data described in section 3.6.
+func rfc6979(q, x *big.Int, data, extraEntropy []byte) *big.Int {
+	qlen := q.BitLen()
+	rolen := (qlen + 7) / 8
+
+	bx := make([]byte, 0, 2*rolen+len(extraEntropy))
+	bx = append(bx, int2octets(x, rolen)...)
+	bx = append(bx, bits2octets(data, q, rolen)...)
+	bx = append(bx, extraEntropy...)
+
+	v := bytes.Repeat([]byte{0x01}, sha256.Size)
+	k := make([]byte, sha256.Size)
+
+	k = hmacSHA256(k, v, []byte{0x00}, bx)
+	v = hmacSHA256(k, v)
+	k = hmacSHA256(k, v, []byte{0x01}, bx)
+	v = hmacSHA256(k, v)
+
+	for {
+		var t []byte
+		for len(t) < rolen {
+			v = hmacSHA256(k, v)
+			t = append(t, v...)
+		}
+		secret := bits2int(t, qlen)
+		if secret.Sign() > 0 && secret.Cmp(q) < 0 {
+			return secret
+		}
+		k = hmacSHA256(k, v, []byte{0x00})
+		v = hmacSHA256(k, v)
+	}
+}
+
+func hmacSHA256(key []byte, data ...[]byte) []byte {
+	mac := hmac.New(sha256.New, key)
+	for _, d := range data {
+		mac.Write(d)
+	}
+	return mac.Sum(nil)
+}
+
+// bits2int implements RFC 6979 section 2.3.2.
+func bits2int(in []byte, qlen int) *big.Int {
+	v := new(big.Int).SetBytes(in)
+	if blen := len(in) * 8; blen > qlen {
+		v.Rsh(v, uint(blen-qlen))
+	}
+	return v
+}
+
+// int2octets implements RFC 6979 section 2.3.3.
+func int2octets(v *big.Int, rolen int) []byte {
+	out := make([]byte, rolen)
+	return v.FillBytes(out)
+}
+
+// bits2octets implements RFC 6979 section 2.3.4.
+func bits2octets(in []byte, q *big.Int, rolen int) []byte {
+	z1 := bits2int(in, q.BitLen())
+	z2 := new(big.Int).Sub(z1, q)
+	if z2.Sign() < 0 {
+		return int2octets(z1, rolen)
+	}
+	return int2octets(z2, rolen)
+}
@@ -0,0 +1,194 @@
+package starkex
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"math/big"
+	"strings"
+	"testing"
+)
+
+// The STARK key pair used by the test suite of the dydx3 Python client.
+const (
+	mockPrivateKey = "0x58c7d5a90b1776bde86ebac077e053ed85b0f7164f53b080304a531947f46e3"
+	mockPublicKey  = "0x3b865a18323b8d147a12c556bfb1d502516c325b1477a23ba6c77af31f020fd"
+)
+
+func decInt(t *testing.T, s string) *big.Int {
+	t.Helper()
+	v, ok := new(big.Int).SetString(s, 10)
+	if !ok {
+		t.Fatalf("cannot parse %q", s)
+	}
+	return v
+}
+
+func mockSigner(t *testing.T) *Signer {
+	t.Helper()
+	s, err := NewSigner("", mockPrivateKey, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	return s
+}
+
+func TestPrivateKeyToPublicKey(t *testing.T) {
+	pub := PrivateKeyToPublicKey(hexInt(t, mockPrivateKey))
+	if want := hexInt(t, mockPublicKey); pub.X.Cmp(want) != 0 {
+		t.Errorf("public key = %#x, want %#x", pub.X, want)
+	}
+	if !pub.IsOnCurve() {
+		t.Error("public key is not on the curve")
+	}
+
+	// Key pair from StarkWare's signature test data.
+	pub = PrivateKeyToPublicKey(decInt(t, "104397037759416840641267745129360920341912682966983343798870479003077644689"))
+	if want := decInt(t, "1913222325711601599563860015182907040361852177892954047964358042507353067365"); pub.X.Cmp(want) != 0 {
+		t.Errorf("public key x = %s, want %s", pub.X, want)
+	}
+	if want := decInt(t, "798905265292544287704154888908626830160713383708400542998012716235575472365"); pub.Y.Cmp(want) != 0 {
+		t.Errorf("public key y = %s, want %s", pub.Y, want)
+	}
+}
+
+func TestSignKnownAnswer(t *testing.T) {
+	// StarkWare's signature test data. The nonce is derived with RFC 6979,
+	// so the signature is fixed.
+	privateKey := decInt(t, "104397037759416840641267745129360920341912682966983343798870479003077644689")
+	msgHash := decInt(t, "2680576269831035412725132645807649347045997097070150916157159360688041452746")
+	wantR := decInt(t, "607684330780324271206686790958794501662789535258258105407533051445036595885")
+	wantS := decInt(t, "453590782387078613313238308551260565642934039343903827708036287031471258875")
+
+	r, s, err := Sign(msgHash, privateKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if r.Cmp(wantR) != 0 || s.Cmp(wantS) != 0 {
+		t.Errorf("Sign() = (%s, %s), want (%s, %s)", r, s, wantR, wantS)
+	}
+	if !Verify(msgHash, r, s, PrivateKeyToPublicKey(privateKey)) {
+		t.Error("signature does not verify")
+	}
+}
+
+func TestVerifyKnownAnswer(t *testing.T) {
+	// StarkWare's signature test data, with an uncompressed public key.
+	raw, err := hex.DecodeString("04033f45f07e1bd1a51b45fc24ec8c8c9908db9e42191be9e169bfcac0c0d997450319d0f53f6ca077c4fa5207819144a2a4165daef6ee47a7c1d06c0dcaa3e456")
+	if err != nil {
+		t.Fatal(err)
+	}
+	pub := Point{X: new(big.Int).SetBytes(raw[1:33]), Y: new(big.Int).SetBytes(raw[33:])}
+	msgHash := hexInt(t, "0x7f15c38ea577a26f4f553282fcfe4f1feeb8ecfaad8f221ae41abf8224cbddd")
+	r := decInt(t, "2458502865976494910213617956670505342647705497324144349552978333078363662855")
+	s := decInt(t, "3439514492576562277095748549117516048613512930236865921315982886313695689433")
+	if !Verify(msgHash, r, s, pub) {
+		t.Error("signature does not verify")
+	}
+}
+
+func TestSignVerifyRoundTrip(t *testing.T) {
+	for i := 0; i < 5; i++ {
+		privateKey, err := rand.Int(rand.Reader, new(big.Int).Sub(EcOrder, big.NewInt(1)))
+		if err != nil {
+			t.Fatal(err)
+		}
+		privateKey.Add(privateKey, big.NewInt(1))
+		msgHash, err := rand.Int(rand.Reader, new(big.Int).Lsh(big.NewInt(1), nElementBitsECDSA))
+		if err != nil {
+			t.Fatal(err)
+		}
+		pub := PrivateKeyToPublicKey(privateKey)
+
+		r, s, err := Sign(msgHash, privateKey)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !Verify(msgHash, r, s, pub) {
+			t.Fatalf("signature of %#x with %#x does not verify", msgHash, privateKey)
+		}
+
+		one := big.NewInt(1)
+		if Verify(msgHash, new(big.Int).Add(r, one), s, pub) {
+			t.Error("signature with tampered r verifies")
+		}
+		if Verify(msgHash, r, new(big.Int).Add(s, one), pub) {
+			t.Error("signature with tampered s verifies")
+		}
+		if Verify(new(big.Int).Add(msgHash, one), r, s, pub) {
+			t.Error("signature of tampered hash verifies")
+		}
+		if Verify(msgHash, r, s, PrivateKeyToPublicKey(new(big.Int).Add(privateKey, one))) {
+			t.Error("signature verifies with the wrong public key")
+		}
+	}
+}
+
+func TestSignErrors(t *testing.T) {
+	one := big.NewInt(1)
+	if _, _, err := Sign(new(big.Int).Lsh(one, nElementBitsECDSA), one); err == nil {
+		t.Error("expected an error for a message hash out of range")
+	}
+	if _, _, err := Sign(one, big.NewInt(0)); err == nil {
+		t.Error("expected an error for a zero private key")
+	}
+	if _, _, err := Sign(one, EcOrder); err == nil {
+		t.Error("expected an error for a private key out of range")
+	}
+}
+
+func TestNewSigner(t *testing.T) {
+	pub := PrivateKeyToPublicKey(hexInt(t, mockPrivateKey))
+	mockPublicKeyYCoordinate := "0x" + pub.Y.Text(16)
+	s, err := NewSigner(mockPublicKey, mockPrivateKey, mockPublicKeyYCoordinate)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s.PublicKey() != mockPublicKey {
+		t.Errorf("PublicKey() = %s, want %s", s.PublicKey(), mockPublicKey)
+	}
+	if s.PublicKeyYCoordinate() != mockPublicKeyYCoordinate {
+		t.Errorf("PublicKeyYCoordinate() = %s, want %s", s.PublicKeyYCoordinate(), mockPublicKeyYCoordinate)
+	}
+
+	tests := []struct {
+		name                                        string
+		publicKey, privateKey, publicKeyYCoordinate string
+		wantErr                                     string
+	}{
+		{"mismatched public key", "0x1234", mockPrivateKey, "", "does not match"},
+		{"mismatched y-coordinate", mockPublicKey, mockPrivateKey, "0x1234", "does not match"},
+		{"malformed public key", "0xzz", mockPrivateKey, "", "invalid stark public key"},
+		{"malformed private key", "", "0xzz", "", "invalid stark private key"},
+		{"zero private key", "", "0x0", "", "out of range"},
+		{"private key out of range", "", "0x" + EcOrder.Text(16), "", "out of range"},
+	}
+	for _, tt := range tests {
+		_, err := NewSigner(tt.publicKey, tt.privateKey, tt.publicKeyYCoordinate)
+		if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+			t.Errorf("%s: got error %v, want %q", tt.name, err, tt.wantErr)
+		}
+	}
+}
+
+func TestSerializeSignature(t *testing.T) {
+	r, s := big.NewInt(0x1234), big.NewInt(0xabcd)
+	sig := SerializeSignature(r, s)
+	if len(sig) != 128 {
+		t.Fatalf("signature has length %d, want 128", len(sig))
+	}
+	for _, in := range []string{sig, "0x" + sig} {
+		gotR, gotS, err := DeserializeSignature(in)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if gotR.Cmp(r) != 0 || gotS.Cmp(s) != 0 {
+			t.Errorf("DeserializeSignature(%s) = (%s, %s), want (%s, %s)", in, gotR, gotS, r, s)
+		}
+	}
+
+	for _, in := range []string{sig[:127], sig + "00", strings.Repeat("z", 128)} {
+		if _, _, err := DeserializeSignature(in); err == nil {
+			t.Errorf("DeserializeSignature(%s): expected an error", in)
+		}
+	}
+}
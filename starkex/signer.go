@@ -0,0 +1,125 @@
+package starkex
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+)
+
+// KeyPair is a hex-encoded STARK key pair.
+type KeyPair struct {
+	PublicKey            string
+	PublicKeyYCoordinate string
+	PrivateKey           string
+}
+
+// NewKeyPair returns the key pair of privateKey.
+func NewKeyPair(privateKey *big.Int) (*KeyPair, error) {
+	if privateKey.Sign() <= 0 || privateKey.Cmp(EcOrder) >= 0 {
+		return nil, errors.New("stark private key is out of range")
+	}
+	pub := PrivateKeyToPublicKey(privateKey)
+	return &KeyPair{
+		PublicKey:            fmt.Sprintf("0x%x", pub.X),
+		PublicKeyYCoordinate: fmt.Sprintf("0x%x", pub.Y),
+		PrivateKey:           fmt.Sprintf("0x%x", privateKey),
+	}, nil
+}
+
+// Signer signs StarkEx messages with a STARK private key.
+type Signer struct {
+	privateKey *big.Int
+	publicKey  Point
+}
+
+// NewSigner creates a Signer out of a hex-encoded STARK private key.
+// publicKey and publicKeyYCoordinate are optional but if provided, they
+// must match the public key derived from privateKey.
+func NewSigner(publicKey, privateKey, publicKeyYCoordinate string) (*Signer, error) {
+	priv, err := parseHex(privateKey)
+	if err != nil {
+		return nil, fmt.Errorf("invalid stark private key: %w", err)
+	}
+	if priv.Sign() <= 0 || priv.Cmp(EcOrder) >= 0 {
+		return nil, errors.New("stark private key is out of range")
+	}
+
+	pub := PrivateKeyToPublicKey(priv)
+	if publicKey != "" {
+		x, err := parseHex(publicKey)
+		if err != nil {
+			return nil, fmt.Errorf("invalid stark public key: %w", err)
+		}
+		if x.Cmp(pub.X) != 0 {
+			return nil, errors.New("stark public key does not match the private key")
+		}
+	}
+	if publicKeyYCoordinate != "" {
+		y, err := parseHex(publicKeyYCoordinate)
+		if err != nil {
+			return nil, fmt.Errorf("invalid stark public key y-coordinate: %w", err)
+		}
+		if y.Cmp(pub.Y) != 0 {
+			return nil, errors.New("stark public key y-coordinate does not match the private key")
+		}
+	}
+
+	return &Signer{
+		privateKey: priv,
+		publicKey:  pub,
+	}, nil
+}
+
+// PublicKey returns the hex-encoded x-coordinate of the STARK public key.
+func (s Signer) PublicKey() string {
+	return fmt.Sprintf("0x%x", s.publicKey.X)
+}
+
+// PublicKeyYCoordinate returns the hex-encoded y-coordinate of the STARK
+// public key.
+func (s Signer) PublicKeyYCoordinate() string {
+	return fmt.Sprintf("0x%x", s.publicKey.Y)
+}
+
+// Sign signs msgHash and returns the signature in the format expected by
+// the dYdX API.
+func (s Signer) Sign(msgHash *big.Int) (string, error) {
+	r, sig, err := Sign(msgHash, s.privateKey)
+	if err != nil {
+		return "", err
+	}
+	return SerializeSignature(r, sig), nil
+}
+
+// SerializeSignature encodes a signature as the 32-byte hex-encoded r
+// followed by the 32-byte hex-encoded s.
+func SerializeSignature(r, s *big.Int) string {
+	return fmt.Sprintf("%064x%064x", r, s)
+}
+
+// DeserializeSignature decodes a signature produced by SerializeSignature.
+func DeserializeSignature(signature string) (r, s *big.Int, err error) {
+	signature = strings.TrimPrefix(signature, "0x")
+	if len(signature) != 128 {
+		return nil, nil, fmt.Errorf("invalid signature length: %d", len(signature))
+	}
+	r, err = parseHex(signature[:64])
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid signature r: %w", err)
+	}
+	s, err = parseHex(signature[64:])
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid signature s: %w", err)
+	}
+	return r, s, nil
+}
+
+// parseHex parses a hex-encoded integer with an optional 0x prefix.
+func parseHex(s string) (*big.Int, error) {
+	v, ok := new(big.Int).SetString(strings.TrimPrefix(strings.ToLower(s), "0x"), 16)
+	if !ok {
+		return nil, fmt.Errorf("cannot parse hex: %q", s)
+	}
+	return v, nil
+}
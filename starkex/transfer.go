@@ -0,0 +1,84 @@
+package starkex
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/tselementes/dydx-v3-go/constants"
+	"github.com/tselementes/dydx-v3-go/types"
+)
+
+const (
+	transferPrefix      = 4
+	transferPaddingBits = 81
+)
+
+// SignableTransfer is a StarkEx transfer of collateral between two
+// positions.
+type SignableTransfer struct {
+	AssetIDCollateral    *big.Int
+	SenderPositionID     *big.Int
+	ReceiverPositionID   *big.Int
+	ReceiverPublicKey    *big.Int
+	QuantumsAmount       *big.Int
+	Nonce                *big.Int
+	ExpirationEpochHours *big.Int
+}
+
+// NewSignableTransfer converts a transfer request from positionId into
+// the StarkEx transfer that needs to be signed.
+func NewSignableTransfer(networkId int, positionId string, req *types.TransferRequest) (*SignableTransfer, error) {
+	collateralAssetID, err := collateralAssetID(networkId)
+	if err != nil {
+		return nil, err
+	}
+	senderPositionID, err := parseInt("sender position id", positionId)
+	if err != nil {
+		return nil, err
+	}
+	receiverPositionID, err := parseInt("receiver position id", req.ReceiverPositionId)
+	if err != nil {
+		return nil, err
+	}
+	receiverPublicKey, err := parseHex(req.ReceiverPublicKey)
+	if err != nil {
+		return nil, fmt.Errorf("invalid receiver public key: %w", err)
+	}
+	quantumsAmount, err := toQuantumsExact(req.Amount, constants.COLLATERAL_ASSET)
+	if err != nil {
+		return nil, err
+	}
+	expirationEpochHours, err := toEpochHours(req.Expiration)
+	if err != nil {
+		return nil, err
+	}
+
+	return &SignableTransfer{
+		AssetIDCollateral:    collateralAssetID,
+		SenderPositionID:     senderPositionID,
+		ReceiverPositionID:   receiverPositionID,
+		ReceiverPublicKey:    receiverPublicKey,
+		QuantumsAmount:       quantumsAmount,
+		Nonce:                NonceFromClientID(req.ClientID),
+		ExpirationEpochHours: expirationEpochHours,
+	}, nil
+}
+
+// Hash computes the Pedersen hash of the transfer, which is the message
+// that gets signed.
+func (t SignableTransfer) Hash() (*big.Int, error) {
+	part1, err := transferAssetsHash(t.AssetIDCollateral, t.ReceiverPublicKey)
+	if err != nil {
+		return nil, err
+	}
+	return transferHash(
+		part1,
+		transferPrefix,
+		transferPaddingBits,
+		t.SenderPositionID,
+		t.ReceiverPositionID,
+		t.QuantumsAmount,
+		t.Nonce,
+		t.ExpirationEpochHours,
+	)
+}
@@ -0,0 +1,101 @@
+package starkex
+
+import (
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/tselementes/dydx-v3-go/constants"
+	"github.com/tselementes/dydx-v3-go/types"
+)
+
+// mockTransfer mirrors TRANSFER_PARAMS of the dydx3 Python client test
+// suite.
+func mockTransfer() *types.TransferRequest {
+	return &types.TransferRequest{
+		Amount:             "49.478023",
+		ReceiverPublicKey:  "0x05135ef87716b0faecec3ba672d145a6daad0aa46437c365d490022115aba674",
+		ReceiverPositionId: "67890",
+		Expiration:         "2020-09-17T04:15:55.028Z",
+		ClientID:           "This is an ID that the client came up with to describe this transfer",
+	}
+}
+
+// shl returns v shifted left by bits.
+func shl(v *big.Int, bits uint) *big.Int {
+	return new(big.Int).Lsh(v, bits)
+}
+
+// or returns the sum of vs, which is their bitwise or when they do not
+// overlap.
+func or(vs ...*big.Int) *big.Int {
+	sum := new(big.Int)
+	for _, v := range vs {
+		sum.Add(sum, v)
+	}
+	return sum
+}
+
+func mustPedersen(t *testing.T, a, b *big.Int) *big.Int {
+	t.Helper()
+	h, err := PedersenHash(a, b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return h
+}
+
+func TestTransferHash(t *testing.T) {
+	// The expected hash follows the StarkEx transfer layout field by
+	// field: no fee is charged, so the fee asset id and the maximum fee
+	// are zero and the fee is taken from the sender position.
+	var (
+		assetID     = hexInt(t, constants.COLLATERAL_ASSET_ID_BY_NETWORK_ID[constants.NETWORK_ID_ROPSTEN])
+		feeAssetID  = big.NewInt(0)
+		receiverKey = hexInt(t, mockTransfer().ReceiverPublicKey)
+		sender      = big.NewInt(12345)
+		receiver    = big.NewInt(67890)
+		feePosition = sender
+		nonce       = NonceFromClientID(mockTransfer().ClientID)
+		amount      = big.NewInt(49478023)
+		maxFee      = big.NewInt(0)
+		expiration  = big.NewInt(444533)
+	)
+	part1 := mustPedersen(t, mustPedersen(t, assetID, feeAssetID), receiverKey)
+	part2 := or(shl(sender, 64+64+32), shl(receiver, 64+32), shl(feePosition, 32), nonce)
+	part3 := or(shl(big.NewInt(4), 64+64+32+81), shl(amount, 64+32+81), shl(maxFee, 32+81), shl(expiration, 81))
+	want := mustPedersen(t, mustPedersen(t, part1, part2), part3)
+
+	tr, err := NewSignableTransfer(constants.NETWORK_ID_ROPSTEN, mockPositionID, mockTransfer())
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := tr.Hash()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.Cmp(want) != 0 {
+		t.Errorf("hash = %#x, want %#x", got, want)
+	}
+}
+
+func TestNewSignableTransferErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		change  func(*types.TransferRequest)
+		wantErr string
+	}{
+		{"malformed receiver public key", func(r *types.TransferRequest) { r.ReceiverPublicKey = "0xnope" }, "invalid receiver public key"},
+		{"invalid receiver position id", func(r *types.TransferRequest) { r.ReceiverPositionId = "" }, "invalid receiver position id"},
+		{"inexact amount", func(r *types.TransferRequest) { r.Amount = "0.0000001" }, "quantum size"},
+		{"malformed expiration", func(r *types.TransferRequest) { r.Expiration = "2020-13-01" }, "invalid expiration"},
+	}
+	for _, tt := range tests {
+		req := mockTransfer()
+		tt.change(req)
+		_, err := NewSignableTransfer(constants.NETWORK_ID_ROPSTEN, mockPositionID, req)
+		if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+			t.Errorf("%s: got error %v, want %q", tt.name, err, tt.wantErr)
+		}
+	}
+}
@@ -0,0 +1,84 @@
+package starkex
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/tselementes/dydx-v3-go/types"
+)
+
+// Signable is a StarkEx message that can be signed.
+type Signable interface {
+	// Hash computes the Pedersen hash of the message, which is what
+	// gets signed.
+	Hash() (*big.Int, error)
+}
+
+// VerifySignature reports whether signature is a valid signature of
+// signable for the STARK public key with the hex-encoded coordinates
+// publicKey and publicKeyYCoordinate. An error is returned if the
+// message cannot be hashed or the signature or public key are malformed.
+func VerifySignature(signable Signable, signature, publicKey, publicKeyYCoordinate string) (bool, error) {
+	x, err := parseHex(publicKey)
+	if err != nil {
+		return false, fmt.Errorf("invalid stark public key: %w", err)
+	}
+	y, err := parseHex(publicKeyYCoordinate)
+	if err != nil {
+		return false, fmt.Errorf("invalid stark public key y-coordinate: %w", err)
+	}
+	pub := Point{X: x, Y: y}
+	if !pub.IsOnCurve() {
+		return false, errors.New("stark public key is not on the curve")
+	}
+	r, s, err := DeserializeSignature(signature)
+	if err != nil {
+		return false, err
+	}
+	h, err := signable.Hash()
+	if err != nil {
+		return false, fmt.Errorf("cannot hash message: %w", err)
+	}
+	return Verify(h, r, s, pub), nil
+}
+
+// VerifyOrder reports whether req.Signature is a valid signature of the
+// order placed by positionId for the given STARK public key.
+func VerifyOrder(networkId int, positionId string, req *types.OrderRequest, publicKey, publicKeyYCoordinate string) (bool, error) {
+	signable, err := NewSignableOrder(networkId, positionId, req)
+	if err != nil {
+		return false, err
+	}
+	return VerifySignature(signable, req.Signature, publicKey, publicKeyYCoordinate)
+}
+
+// VerifyWithdrawal reports whether req.Signature is a valid signature of
+// the withdrawal from positionId for the given STARK public key.
+func VerifyWithdrawal(networkId int, positionId string, req *types.WithdrawalRequest, publicKey, publicKeyYCoordinate string) (bool, error) {
+	signable, err := NewSignableWithdrawal(networkId, positionId, req)
+	if err != nil {
+		return false, err
+	}
+	return VerifySignature(signable, req.Signature, publicKey, publicKeyYCoordinate)
+}
+
+// VerifyFastWithdrawal reports whether req.Signature is a valid signature
+// of the fast withdrawal from positionId for the given STARK public key.
+func VerifyFastWithdrawal(networkId int, positionId string, req *types.FastWithdrawalRequest, publicKey, publicKeyYCoordinate string) (bool, error) {
+	signable, err := NewSignableFastWithdrawal(networkId, positionId, req)
+	if err != nil {
+		return false, err
+	}
+	return VerifySignature(signable, req.Signature, publicKey, publicKeyYCoordinate)
+}
+
+// VerifyTransfer reports whether req.Signature is a valid signature of
+// the transfer from positionId for the given STARK public key.
+func VerifyTransfer(networkId int, positionId string, req *types.TransferRequest, publicKey, publicKeyYCoordinate string) (bool, error) {
+	signable, err := NewSignableTransfer(networkId, positionId, req)
+	if err != nil {
+		return false, err
+	}
+	return VerifySignature(signable, req.Signature, publicKey, publicKeyYCoordinate)
+}
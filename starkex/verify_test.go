@@ -0,0 +1,143 @@
+package starkex
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/tselementes/dydx-v3-go/constants"
+	"github.com/tselementes/dydx-v3-go/types"
+)
+
+func mockFastWithdrawal() *types.FastWithdrawalRequest {
+	return &types.FastWithdrawalRequest{
+		CreditAsset:  constants.COLLATERAL_ASSET,
+		CreditAmount: "49",
+		DebitAmount:  "49.478023",
+		ToAddress:    "0x1234567890123456789012345678901234567890",
+		LpPositionId: "67890",
+		LpStarkKey:   "0x05135ef87716b0faecec3ba672d145a6daad0aa46437c365d490022115aba674",
+		Expiration:   "2020-09-17T04:15:55.028Z",
+		ClientID:     "This is an ID that the client came up with to describe this withdrawal",
+	}
+}
+
+// signed signs signable with the mock key and returns the signature.
+func signed(t *testing.T, signable Signable, err error) string {
+	t.Helper()
+	if err != nil {
+		t.Fatal(err)
+	}
+	h, err := signable.Hash()
+	if err != nil {
+		t.Fatal(err)
+	}
+	signature, err := mockSigner(t).Sign(h)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return signature
+}
+
+func TestVerifyRoundTrip(t *testing.T) {
+	signer := mockSigner(t)
+	pub, pubY := signer.PublicKey(), signer.PublicKeyYCoordinate()
+	other, err := NewKeyPair(hexInt(t, "0x1234"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	network := constants.NETWORK_ID_ROPSTEN
+
+	order := mockOrder()
+	o, err := NewSignableOrder(network, mockPositionID, order)
+	order.Signature = signed(t, o, err)
+
+	withdrawal := mockWithdrawal()
+	w, err := NewSignableWithdrawal(network, mockPositionID, withdrawal)
+	withdrawal.Signature = signed(t, w, err)
+
+	fastWithdrawal := mockFastWithdrawal()
+	fw, err := NewSignableFastWithdrawal(network, mockPositionID, fastWithdrawal)
+	fastWithdrawal.Signature = signed(t, fw, err)
+
+	transfer := mockTransfer()
+	tr, err := NewSignableTransfer(network, mockPositionID, transfer)
+	transfer.Signature = signed(t, tr, err)
+
+	tests := []struct {
+		name   string
+		verify func(pub, pubY string) (bool, error)
+		tamper func()
+	}{
+		{
+			name: "order",
+			verify: func(pub, pubY string) (bool, error) {
+				return VerifyOrder(network, mockPositionID, order, pub, pubY)
+			},
+			tamper: func() { order.Price = "350.00068" },
+		},
+		{
+			name: "withdrawal",
+			verify: func(pub, pubY string) (bool, error) {
+				return VerifyWithdrawal(network, mockPositionID, withdrawal, pub, pubY)
+			},
+			tamper: func() { withdrawal.Amount = "49.478024" },
+		},
+		{
+			name: "fast withdrawal",
+			verify: func(pub, pubY string) (bool, error) {
+				return VerifyFastWithdrawal(network, mockPositionID, fastWithdrawal, pub, pubY)
+			},
+			tamper: func() { fastWithdrawal.ToAddress = "0x0000000000000000000000000000000000000001" },
+		},
+		{
+			name: "transfer",
+			verify: func(pub, pubY string) (bool, error) {
+				return VerifyTransfer(network, mockPositionID, transfer, pub, pubY)
+			},
+			tamper: func() { transfer.ReceiverPositionId = "67891" },
+		},
+	}
+	for _, tt := range tests {
+		if ok, err := tt.verify(pub, pubY); err != nil || !ok {
+			t.Errorf("%s: Verify = %v, %v, want true", tt.name, ok, err)
+		}
+		if ok, err := tt.verify(other.PublicKey, other.PublicKeyYCoordinate); err != nil || ok {
+			t.Errorf("%s: Verify with another key = %v, %v, want false", tt.name, ok, err)
+		}
+		tt.tamper()
+		if ok, err := tt.verify(pub, pubY); err != nil || ok {
+			t.Errorf("%s: Verify of a tampered request = %v, %v, want false", tt.name, ok, err)
+		}
+	}
+}
+
+func TestVerifySignatureErrors(t *testing.T) {
+	signer := mockSigner(t)
+	w, err := NewSignableWithdrawal(constants.NETWORK_ID_ROPSTEN, mockPositionID, mockWithdrawal())
+	signature := signed(t, w, err)
+
+	tests := []struct {
+		name      string
+		signature string
+		pub       string
+		pubY      string
+		wantErr   string
+	}{
+		{"point not on the curve", signature, signer.PublicKey(), "0x1", "not on the curve"},
+		{"malformed public key", signature, "0xzz", signer.PublicKeyYCoordinate(), "invalid stark public key"},
+		{"malformed y-coordinate", signature, signer.PublicKey(), "", "invalid stark public key y-coordinate"},
+		{"short signature", signature[:126], signer.PublicKey(), signer.PublicKeyYCoordinate(), "invalid signature length"},
+		{"long signature", signature + "00", signer.PublicKey(), signer.PublicKeyYCoordinate(), "invalid signature length"},
+		{"malformed signature", strings.Repeat("g", 128), signer.PublicKey(), signer.PublicKeyYCoordinate(), "invalid signature r"},
+	}
+	for _, tt := range tests {
+		ok, err := VerifySignature(w, tt.signature, tt.pub, tt.pubY)
+		if ok || err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+			t.Errorf("%s: got %v, %v, want error %q", tt.name, ok, err, tt.wantErr)
+		}
+	}
+
+	if _, err := VerifyOrder(42, mockPositionID, mockOrder(), signer.PublicKey(), signer.PublicKeyYCoordinate()); err == nil {
+		t.Error("expected an error for a request that cannot be hashed")
+	}
+}
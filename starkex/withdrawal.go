@@ -0,0 +1,89 @@
+package starkex
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/tselementes/dydx-v3-go/constants"
+	"github.com/tselementes/dydx-v3-go/types"
+)
+
+const (
+	withdrawalPrefix      = 6
+	withdrawalPaddingBits = 49
+
+	withdrawalAssetIDBits              = 250
+	withdrawalPositionIDBits           = 64
+	withdrawalQuantumsAmountBits       = 64
+	withdrawalExpirationEpochHoursBits = 32
+)
+
+// SignableWithdrawal is a StarkEx withdrawal of collateral to L1.
+type SignableWithdrawal struct {
+	AssetIDCollateral    *big.Int
+	PositionID           *big.Int
+	QuantumsAmount       *big.Int
+	Nonce                *big.Int
+	ExpirationEpochHours *big.Int
+}
+
+// NewSignableWithdrawal converts a withdrawal request from positionId into
+// the StarkEx withdrawal that needs to be signed.
+func NewSignableWithdrawal(networkId int, positionId string, req *types.WithdrawalRequest) (*SignableWithdrawal, error) {
+	if req.Asset != constants.COLLATERAL_ASSET {
+		return nil, fmt.Errorf("only %s can be withdrawn: %s", constants.COLLATERAL_ASSET, req.Asset)
+	}
+	collateralAssetID, err := collateralAssetID(networkId)
+	if err != nil {
+		return nil, err
+	}
+	quantumsAmount, err := toQuantumsExact(req.Amount, constants.COLLATERAL_ASSET)
+	if err != nil {
+		return nil, err
+	}
+	positionID, err := parseInt("position id", positionId)
+	if err != nil {
+		return nil, err
+	}
+	expirationEpochHours, err := toEpochHours(req.Expiration)
+	if err != nil {
+		return nil, err
+	}
+
+	return &SignableWithdrawal{
+		AssetIDCollateral:    collateralAssetID,
+		PositionID:           positionID,
+		QuantumsAmount:       quantumsAmount,
+		Nonce:                NonceFromClientID(req.ClientID),
+		ExpirationEpochHours: expirationEpochHours,
+	}, nil
+}
+
+// Hash computes the Pedersen hash of the withdrawal, which is the message
+// that gets signed.
+func (w SignableWithdrawal) Hash() (*big.Int, error) {
+	for _, f := range []struct {
+		name string
+		v    *big.Int
+		bits int
+	}{
+		{"collateral asset id", w.AssetIDCollateral, withdrawalAssetIDBits},
+		{"position id", w.PositionID, withdrawalPositionIDBits},
+		{"nonce", w.Nonce, nonceBitLength},
+		{"quantums amount", w.QuantumsAmount, withdrawalQuantumsAmountBits},
+		{"expiration epoch hours", w.ExpirationEpochHours, withdrawalExpirationEpochHoursBits},
+	} {
+		if err := checkBitLength(f.name, f.v, f.bits); err != nil {
+			return nil, err
+		}
+	}
+
+	packed := big.NewInt(withdrawalPrefix)
+	packed.Lsh(packed, withdrawalPositionIDBits).Add(packed, w.PositionID)
+	packed.Lsh(packed, nonceBitLength).Add(packed, w.Nonce)
+	packed.Lsh(packed, withdrawalQuantumsAmountBits).Add(packed, w.QuantumsAmount)
+	packed.Lsh(packed, withdrawalExpirationEpochHoursBits).Add(packed, w.ExpirationEpochHours)
+	packed.Lsh(packed, withdrawalPaddingBits)
+
+	return PedersenHash(w.AssetIDCollateral, packed)
+}
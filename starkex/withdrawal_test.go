@@ -0,0 +1,69 @@
+package starkex
+
+import (
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/tselementes/dydx-v3-go/constants"
+	"github.com/tselementes/dydx-v3-go/types"
+)
+
+// mockWithdrawal mirrors WITHDRAWAL_PARAMS of the dydx3 Python client test
+// suite.
+func mockWithdrawal() *types.WithdrawalRequest {
+	return &types.WithdrawalRequest{
+		Amount:     "49.478023",
+		Asset:      constants.COLLATERAL_ASSET,
+		Expiration: "2020-09-17T04:15:55.028Z",
+		ClientID:   "This is an ID that the client came up with to describe this withdrawal",
+	}
+}
+
+func TestSignWithdrawalKnownAnswer(t *testing.T) {
+	// MOCK_SIGNATURE of the withdrawal tests of the dydx3 Python client.
+	const want = "05e48c33f8205a5359c95f1bd7385c1c1f587e338a514298c07634c0b6c952ba0687d6980502a5d7fa84ef6fdc00104db22c43c7fb83e88ca84f19faa9ee3de1"
+
+	w, err := NewSignableWithdrawal(constants.NETWORK_ID_ROPSTEN, mockPositionID, mockWithdrawal())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if w.QuantumsAmount.Cmp(big.NewInt(49478023)) != 0 {
+		t.Errorf("quantums amount = %s, want 49478023", w.QuantumsAmount)
+	}
+	if w.ExpirationEpochHours.Cmp(big.NewInt(444533)) != 0 {
+		t.Errorf("expiration epoch hours = %s, want 444533", w.ExpirationEpochHours)
+	}
+
+	h, err := w.Hash()
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := mockSigner(t).Sign(h)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got != want {
+		t.Errorf("signature = %s, want %s", got, want)
+	}
+}
+
+func TestNewSignableWithdrawalErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		change  func(*types.WithdrawalRequest)
+		wantErr string
+	}{
+		{"unsupported asset", func(w *types.WithdrawalRequest) { w.Asset = "ETH" }, "can be withdrawn"},
+		{"inexact amount", func(w *types.WithdrawalRequest) { w.Amount = "49.4780231" }, "quantum size"},
+		{"malformed expiration", func(w *types.WithdrawalRequest) { w.Expiration = "soon" }, "invalid expiration"},
+	}
+	for _, tt := range tests {
+		req := mockWithdrawal()
+		tt.change(req)
+		_, err := NewSignableWithdrawal(constants.NETWORK_ID_ROPSTEN, mockPositionID, req)
+		if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+			t.Errorf("%s: got error %v, want %q", tt.name, err, tt.wantErr)
+		}
+	}
+}
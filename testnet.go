@@ -0,0 +1,80 @@
+package client
+
+import (
+	"crypto/ecdsa"
+	"time"
+
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/tselementes/dydx-v3-go/constants"
+	"github.com/tselementes/dydx-v3-go/starkex"
+	"github.com/tselementes/dydx-v3-go/types"
+)
+
+// TestnetAccount is a disposable, funded dYdX account on Ropsten.
+type TestnetAccount struct {
+	// Client authenticated with all the keys of the account.
+	Client *Client
+	// The Ethereum key the account was onboarded with.
+	EthPrivateKey *ecdsa.PrivateKey
+	// The STARK key pair of the account, derived from EthPrivateKey.
+	StarkKeyPair *starkex.KeyPair
+	// The credentials of the default API key of the account.
+	ApiKeyCredentials map[string]string
+	// The deposit of testnet USDC to the account.
+	Funding *types.Transfer
+}
+
+// BootstrapTestnetAccount creates a new account on NETWORK_ID_ROPSTEN: it
+// generates a new Ethereum key, onboards it with the STARK key derived
+// from it and requests testnet USDC for the account. host is usually
+// API_HOST_ROPSTEN and providerURL is the URL of a Ropsten node.
+func BootstrapTestnetAccount(host string, timeout time.Duration, providerURL string) (*TestnetAccount, error) {
+	ethPrivateKey, err := crypto.GenerateKey()
+	if err != nil {
+		return nil, err
+	}
+	address := crypto.PubkeyToAddress(ethPrivateKey.PublicKey)
+
+	c, err := New(host, timeout, address, ethPrivateKey, constants.NETWORK_ID_ROPSTEN, "", "", "", providerURL, nil)
+	if err != nil {
+		return nil, err
+	}
+	keyPair, err := c.DeriveStarkKey()
+	if err != nil {
+		return nil, err
+	}
+	apiKeyCredentials, err := c.Onboard()
+	if err != nil {
+		return nil, err
+	}
+
+	// Recreate the client now that the account has API keys.
+	c, err = New(
+		host,
+		timeout,
+		address,
+		ethPrivateKey,
+		constants.NETWORK_ID_ROPSTEN,
+		keyPair.PublicKey,
+		keyPair.PrivateKey,
+		keyPair.PublicKeyYCoordinate,
+		providerURL,
+		apiKeyCredentials,
+	)
+	if err != nil {
+		return nil, err
+	}
+	funding, err := c.Private().RequestTestnetTokens()
+	if err != nil {
+		return nil, err
+	}
+
+	return &TestnetAccount{
+		Client:            c,
+		EthPrivateKey:     ethPrivateKey,
+		StarkKeyPair:      keyPair,
+		ApiKeyCredentials: apiKeyCredentials,
+		Funding:           funding,
+	}, nil
+}
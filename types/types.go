@@ -1,5 +1,11 @@
 package types
 
+import (
+	"encoding/json"
+
+	"github.com/tselementes/dydx-v3-go/constants"
+)
+
 type Market struct {
 	// Symbol of the market.
 	Market string `json:"market"`
@@ -355,7 +361,7 @@ type Order struct {
 	// The price of the order. Must adhere to the market's tick size.
 	Price string `json:"price"`
 	// The trigger price of the order. Must adhere to the market's tick size.
-	TriggerPrice *string `json:"trigerPrice,omitempty"`
+	TriggerPrice *string `json:"triggerPrice,omitempty"`
 	// Used for trailing stops. Percent drop from maximum price that will trigger the order.
 	TrailingPercent *string `json:"trailingPercent,omitempty"`
 	// Total size (base currency) of the order
@@ -442,10 +448,62 @@ type GetOrdersResponse struct {
 	Orders []*Order `json:"orders"`
 }
 
+type GetActiveOrdersResponse struct {
+	// Only the id, accountId, market, side, price and remainingSize of active orders are returned.
+	Orders []*Order `json:"orders"`
+}
+
 type GetOrderByIdResponse struct {
 	Order *Order `json:"order"`
 }
 
+type Fill struct {
+	// The unique id assigned by dYdX.
+	ID string `json:"id"`
+	// Either BUY or SELL.
+	Side string `json:"side"`
+	// Either MAKER or TAKER.
+	Liquidity Liquidity `json:"liquidity"`
+	// The type of the order that was filled. LIQUIDATED and LIQUIDATION are only used by fills.
+	Type OrderType `json:"type"`
+	// Market of the fill.
+	Market string `json:"market"`
+	// Id of the order that was filled.
+	OrderID string `json:"orderId"`
+	// The price of the fill.
+	Price string `json:"price"`
+	// The size of the fill.
+	Size string `json:"size"`
+	// The fee charged for the fill, in USDC.
+	Fee string `json:"fee"`
+	// Timestamp when the fill was created.
+	CreatedAt string `json:"createdAt"`
+}
+
+type Liquidity string
+
+const (
+	// The fill added liquidity to the book.
+	Maker Liquidity = "MAKER"
+	// The fill took liquidity from the book.
+	Taker Liquidity = "TAKER"
+)
+
+type GetFillsFilter struct {
+	// Market of the fills.
+	Market *string `json:"market,omitempty"`
+	// Id of the order the fills belong to.
+	OrderID *string `json:"orderId,omitempty"`
+	// The maximum number of fills that can be fetched via this request. Note, this cannot be greater than 100.
+	Limit *string `json:"limit,omitempty"`
+	// Set a date by which the fills had to be created.
+	CreatedBeforeOrAt *string `json:"createdBeforeOrAt,omitempty"`
+}
+
+type GetFillsResponse struct {
+	Fills []*Fill `json:"fills"`
+}
+
 type OrderRequest struct {
 	// Market of the order.
 	Market string `json:"market"`
@@ -478,3 +536,440 @@ type OrderRequest struct {
 	// included, will be done by the client. For more information see above.
 	Signature string `json:"signature"`
 }
+
+type CreateOrderResponse struct {
+	Order *Order `json:"order"`
+}
+
+type CancelOrderResponse struct {
+	CancelOrder *Order `json:"cancelOrder"`
+}
+
+type CancelOrdersResponse struct {
+	CancelOrders []*Order `json:"cancelOrders"`
+}
+
+type ApiKeyCredentials struct {
+	// The API key.
+	Key string `json:"key"`
+	// The secret used to sign requests with the API key.
+	Secret string `json:"secret"`
+	// The passphrase of the API key.
+	Passphrase string `json:"passphrase"`
+}
+
+// Map returns the credentials in the format accepted by private.New.
+func (c ApiKeyCredentials) Map() map[string]string {
+	return map[string]string{
+		"key":        c.Key,
+		"secret":     c.Secret,
+		"passphrase": c.Passphrase,
+	}
+}
+
+type OnboardingRequest struct {
+	// Public StarkKey of the user.
+	StarkKey string `json:"starkKey"`
+	// Public StarkKey y-coordinate of the user.
+	StarkKeyYCoordinate string `json:"starkKeyYCoordinate"`
+	// The affiliate link that referred the user, if any.
+	ReferredByAffiliateLink *string `json:"referredByAffiliateLink,omitempty"`
+	// Country of the user's residence. Must be ISO 3166-1 Alpha-2 compliant.
+	Country *string `json:"country,omitempty"`
+}
+
+type OnboardingResponse struct {
+	// The credentials of the default API key of the user.
+	ApiKey ApiKeyCredentials `json:"apiKey"`
+	// The newly created user.
+	User *User `json:"user"`
+	// The newly created account.
+	Account *Account `json:"account"`
+}
+
+type CreateApiKeyResponse struct {
+	ApiKey *ApiKeyCredentials `json:"apiKey"`
+}
+
+type WithdrawalRequest struct {
+	// Amount to be withdrawn.
+	Amount string `json:"amount"`
+	// Asset being withdrawn. Can currently only be USDC.
+	Asset string `json:"asset"`
+	// Time at which the withdrawal expires if it has not been completed.
+	Expiration string `json:"expiration"`
+	// Unique id of the client associated with the withdrawal. Must be <= 40 characters. When using the client,
+	// if not included, will be randomly generated by the client.
+	ClientID string `json:"clientId"`
+	// Signature for the withdrawal, signed with the account's STARK private key. When using the client, if not
+	// included, will be done by the client.
+	Signature string `json:"signature"`
+}
+
+type WithdrawalResponse struct {
+	Withdrawal *Transfer `json:"withdrawal"`
+}
+
+type Transfer struct {
+	// The unique id assigned by dYdX.
+	ID string `json:"id"`
+	// The type of the transfer.
+	Type TransferType `json:"type"`
+	// The asset that was debited (USDC, BTC etc.).
+	DebitAsset string `json:"debitAsset"`
+	// The asset that was credited (USDC, BTC etc.).
+	CreditAsset string `json:"creditAsset"`
+	// The amount of asset that was debited.
+	DebitAmount string `json:"debitAmount"`
+	// The amount of asset that was credited.
+	CreditAmount string `json:"creditAmount"`
+	// Ethereum transaction hash of the transfer.
+	TransactionHash *string `json:"transactionHash,omitempty"`
+	// The status of the transfer.
+	Status TransferStatus `json:"status"`
+	// Timestamp when the transfer was created.
+	CreatedAt string `json:"createdAt"`
+	// Timestamp when the transfer was confirmed.
+	ConfirmedAt *string `json:"confirmedAt,omitempty"`
+	// The unique id of the transfer assigned by the client.
+	ClientID string `json:"clientId"`
+	// The Ethereum address the transfer is from.
+	FromAddress *string `json:"fromAddress,omitempty"`
+	// The Ethereum address the transfer is for.
+	ToAddress *string `json:"toAddress,omitempty"`
+}
+
+type TransferType string
+
+const (
+	// Deposit from L1.
+	TransferTypeDeposit TransferType = "DEPOSIT"
+	// Slow withdrawal to L1.
+	TransferTypeWithdrawal TransferType = "WITHDRAWAL"
+	// Fast withdrawal to L1 through a liquidity provider.
+	TransferTypeFastWithdrawal TransferType = "FAST_WITHDRAWAL"
+	// Transfer to another L2 account.
+	TransferTypeTransferOut TransferType = "TRANSFER_OUT"
+	// Transfer from another L2 account.
+	TransferTypeTransferIn TransferType = "TRANSFER_IN"
+)
+
+type TransferStatus string
+
+const (
+	TransferStatusPending     TransferStatus = constants.TRANSFER_STATUS_PENDING
+	TransferStatusConfirmed   TransferStatus = constants.TRANSFER_STATUS_CONFIRMED
+	TransferStatusQueued      TransferStatus = constants.TRANSFER_STATUS_QUEUED
+	TransferStatusCanceled    TransferStatus = constants.TRANSFER_STATUS_CANCELED
+	TransferStatusUnconfirmed TransferStatus = constants.TRANSFER_STATUS_UNCONFIRMED
+)
+
+type GetTransfersFilter struct {
+	// The type of the transfers. Can be DEPOSIT, WITHDRAWAL, FAST_WITHDRAWAL, TRANSFER_OUT or TRANSFER_IN.
+	Type *string `json:"transferType,omitempty"`
+	// The maximum number of transfers that can be fetched via this request. Note, this cannot be greater than 100.
+	Limit *string `json:"limit,omitempty"`
+	// Set a date by which the transfers had to be created.
+	CreatedBeforeOrAt *string `json:"createdBeforeOrAt,omitempty"`
+}
+
+type GetTransfersResponse struct {
+	Transfers []*Transfer `json:"transfers"`
+}
+
+type FastWithdrawalRequest struct {
+	// Asset being withdrawn to L1. Can currently only be USDC.
+	CreditAsset string `json:"creditAsset"`
+	// Amount of the credit asset sent to the user on L1.
+	CreditAmount string `json:"creditAmount"`
+	// Amount of USDC debited from the user's L2 account.
+	DebitAmount string `json:"debitAmount"`
+	// Ethereum address the withdrawal is sent to on L1.
+	ToAddress string `json:"toAddress"`
+	// Position id of the liquidity provider.
+	LpPositionId string `json:"lpPositionId"`
+	// Public stark key of the liquidity provider. Only used for signing.
+	LpStarkKey string `json:"-"`
+	// Time at which the withdrawal expires if it has not been completed.
+	Expiration string `json:"expiration"`
+	// Unique id of the client associated with the withdrawal. Must be <= 40 characters. When using the client,
+	// if not included, will be randomly generated by the client.
+	ClientID string `json:"clientId"`
+	// Signature for the withdrawal, signed with the account's STARK private key. When using the client, if not
+	// included, will be done by the client.
+	Signature string `json:"signature"`
+}
+
+type TransferRequest struct {
+	// Amount of USDC to transfer (human readable).
+	Amount string `json:"amount"`
+	// Id of the account receiving the transfer.
+	ReceiverAccountId string `json:"receiverAccountId"`
+	// Public stark key of the receiving account. Only used for signing.
+	ReceiverPublicKey string `json:"-"`
+	// Position id of the receiving account. Only used for signing.
+	ReceiverPositionId string `json:"-"`
+	// Time at which the transfer expires if it has not been completed.
+	Expiration string `json:"expiration"`
+	// Unique id of the client associated with the transfer. Must be <= 40 characters. When using the client,
+	// if not included, will be randomly generated by the client.
+	ClientID string `json:"clientId"`
+	// Signature for the transfer, signed with the account's STARK private key. When using the client, if not
+	// included, will be done by the client.
+	Signature string `json:"signature"`
+}
+
+type TransferResponse struct {
+	Transfer *Transfer `json:"transfer"`
+}
+
+type FundingPayment struct {
+	// Market of the funding payment.
+	Market string `json:"market"`
+	// Change in the quoteBalance of the account. Positive if the user received funding and negative if the user paid funding.
+	Payment string `json:"payment"`
+	// The funding rate (as a 1-hour rate).
+	Rate string `json:"rate"`
+	// The size of the position at the time of the funding payment.
+	PositionSize string `json:"positionSize"`
+	// The oracle price used to calculate the payment.
+	Price string `json:"price"`
+	// Time at which the funding payment was exchanged.
+	EffectiveAt string `json:"effectiveAt"`
+}
+
+type GetFundingPaymentsFilter struct {
+	// Market of the funding payments.
+	Market *string `json:"market,omitempty"`
+	// The maximum number of funding payments that can be fetched via this request. Note, this cannot be greater than 100.
+	Limit *string `json:"limit,omitempty"`
+	// Set a date by which the funding payments had to be exchanged.
+	EffectiveBeforeOrAt *string `json:"effectiveBeforeOrAt,omitempty"`
+}
+
+type GetFundingPaymentsResponse struct {
+	FundingPayments []*FundingPayment `json:"fundingPayments"`
+}
+
+type HistoricalPnlTick struct {
+	// The id of the account.
+	AccountID string `json:"accountId"`
+	// The total account equity.
+	Equity string `json:"equity"`
+	// The total PnL of the account since it was created.
+	TotalPnl string `json:"totalPnl"`
+	// The net amount of transfers in and out of the account.
+	NetTransfers string `json:"netTransfers"`
+	// When the tick was created.
+	CreatedAt string `json:"createdAt"`
+}
+
+type GetHistoricalPnlFilter struct {
+	// Set a date by which the ticks had to be created.
+	CreatedBeforeOrAt *string `json:"createdBeforeOrAt,omitempty"`
+	// Set a date on or after which the ticks had to be created.
+	CreatedOnOrAfter *string `json:"createdOnOrAfter,omitempty"`
+}
+
+type GetHistoricalPnlResponse struct {
+	HistoricalPnl []*HistoricalPnlTick `json:"historicalPnl"`
+}
+
+type TradingRewards struct {
+	// The epoch of the rewards.
+	Epoch int `json:"epoch"`
+	// Time at which the epoch started.
+	EpochStart string `json:"epochStart"`
+	// Time at which the epoch ended.
+	EpochEnd string `json:"epochEnd"`
+	// The fees paid by the user and by all users in the epoch.
+	Fees TradingRewardsFees `json:"fees"`
+	// The open interest of the user and of all users in the epoch.
+	OpenInterest TradingRewardsOpenInterest `json:"openInterest"`
+	// The staked DYDX of the user and of all users in the epoch.
+	StakedDYDX TradingRewardsStakedDYDX `json:"stakedDYDX"`
+	// The weight of the user and of all users in the epoch.
+	Weight RewardsWeight `json:"weight"`
+	// The total DYDX rewards distributed in the epoch.
+	TotalRewards string `json:"totalRewards"`
+	// The estimated DYDX rewards of the user for the epoch.
+	EstimatedRewards string `json:"estimatedRewards"`
+}
+
+type TradingRewardsFees struct {
+	// The fees paid by the user in the epoch.
+	FeesPaid string `json:"feesPaid"`
+	// The fees paid by all users in the epoch.
+	TotalFeesPaid string `json:"totalFeesPaid"`
+}
+
+type TradingRewardsOpenInterest struct {
+	// The average open interest of the user in the epoch.
+	AverageOpenInterest string `json:"averageOpenInterest"`
+	// The average open interest of all users in the epoch.
+	TotalAverageOpenInterest string `json:"totalAverageOpenInterest"`
+}
+
+type TradingRewardsStakedDYDX struct {
+	// The average DYDX staked by the user in the epoch.
+	AverageStakedDYDX string `json:"averageStakedDYDX"`
+	// The average DYDX staked by the user in the epoch, with the floor applied.
+	AverageStakedDYDXWithFloor string `json:"averageStakedDYDXWithFloor"`
+	// The average DYDX staked by all users in the epoch.
+	TotalAverageStakedDYDX string `json:"totalAverageStakedDYDX"`
+}
+
+type RewardsWeight struct {
+	// The weight of the user in the epoch.
+	Weight string `json:"weight"`
+	// The weight of all users in the epoch.
+	TotalWeight string `json:"totalWeight"`
+}
+
+type LiquidityProviderRewards struct {
+	// The epoch of the rewards.
+	Epoch int `json:"epoch"`
+	// Time at which the epoch started.
+	EpochStart string `json:"epochStart"`
+	// Time at which the epoch ended.
+	EpochEnd string `json:"epochEnd"`
+	// The rewards of the user in each market, keyed by market.
+	Markets map[string]LiquidityProviderMarketRewards `json:"markets"`
+	// The staked DYDX of the user and of all users in the epoch.
+	StakedDYDX LiquidityProviderStakedDYDX `json:"stakedDYDX"`
+}
+
+type LiquidityProviderMarketRewards struct {
+	// Market of the rewards.
+	Market string `json:"market"`
+	// The depth and spread score of the user in the market.
+	DepthSpreadScore string `json:"depthSpreadScore"`
+	// The ratio of the epoch the user provided liquidity in the market.
+	Uptime string `json:"uptime"`
+	// The uptime of the user in the market linked to their staked DYDX.
+	LinkedUptime string `json:"linkedUptime"`
+	// The maximum uptime of any user in the market.
+	MaxUptime string `json:"maxUptime"`
+	// The score of the user in the market.
+	Score string `json:"score"`
+	// The score of all users in the market.
+	TotalScore string `json:"totalScore"`
+	// The total DYDX rewards distributed in the market in the epoch.
+	TotalRewards string `json:"totalRewards"`
+	// The estimated DYDX rewards of the user in the market for the epoch.
+	EstimatedRewards string `json:"estimatedRewards"`
+}
+
+type LiquidityProviderStakedDYDX struct {
+	// The average DYDX staked by the user in the epoch.
+	AverageStakedDYDX string `json:"averageStakedDYDX"`
+	// The average DYDX staked by all users in the epoch.
+	TotalAverageStakedDYDX string `json:"totalAverageStakedDYDX"`
+}
+
+type RetroactiveMiningRewards struct {
+	// The number of allocated dYdX tokens for the address.
+	Allocation string `json:"allocation"`
+	// The addresses' required trade volume (in USD) to be able to claim the allocation.
+	TargetVolume string `json:"targetVolume"`
+	// The trade volume (in USD) of the address so far.
+	Volume string `json:"volume"`
+}
+
+type LeaderboardPnl struct {
+	// The top PnLs of the period, sorted as requested.
+	TopPnls []LeaderboardPnlEntry `json:"topPnls"`
+	// The number of users participating in the leaderboard.
+	NumParticipants int `json:"numParticipants"`
+	// Time at which the period started.
+	StartedAt *string `json:"startedAt"`
+	// Time at which the period ends.
+	EndsAt *string `json:"endsAt"`
+	// Time at which the leaderboard was last updated.
+	UpdatedAt string `json:"updatedAt"`
+}
+
+type LeaderboardPnlEntry struct {
+	// The username of the user.
+	Username string `json:"username"`
+	// The Ethereum address of the user.
+	EthereumAddress string `json:"ethereumAddress"`
+	// The public id of the user.
+	PublicID string `json:"publicId"`
+	// The absolute PnL of the user in the period.
+	AbsolutePnl string `json:"absolutePnl"`
+	// The PnL of the user in the period as a percentage of their equity.
+	PercentPnl string `json:"percentPnl"`
+	// The rank of the user by absolute PnL.
+	AbsoluteRank *int `json:"absoluteRank"`
+	// The rank of the user by percent PnL.
+	PercentRank *int `json:"percentRank"`
+}
+
+type PublicProfile struct {
+	// The username of the user.
+	Username string `json:"username"`
+	// The Ethereum address of the user.
+	EthereumAddress string `json:"ethereumAddress"`
+	// The DYDX token holdings of the user.
+	DYDXHoldings *string `json:"DYDXHoldings"`
+	// The staked DYDX token holdings of the user.
+	StakedDYDXHoldings *string `json:"stakedDYDXHoldings"`
+	// The ids of the Hedgies held by the user.
+	HedgiesHeld []int `json:"hedgiesHeld"`
+	// The Twitter handle of the user.
+	TwitterHandle *string `json:"twitterHandle"`
+	// The trading league of the user.
+	TradingLeagues ProfileTradingLeagues `json:"tradingLeagues"`
+	// The PnL and volume of the user over the last 30 days.
+	TradingPnls ProfileTradingPnls `json:"tradingPnls"`
+	// The trading rewards of the user.
+	TradingRewards ProfileTradingRewards `json:"tradingRewards"`
+}
+
+type PrivateProfile struct {
+	PublicProfile
+	// The public id of the user.
+	PublicID string `json:"publicId"`
+	// The affiliate links of the user.
+	AffiliateLinks []AffiliateLink `json:"affiliateLinks"`
+	// The status of the affiliate application of the user.
+	AffiliateApplicationStatus *string `json:"affiliateApplicationStatus"`
+}
+
+type ProfileTradingLeagues struct {
+	// The current league of the user.
+	CurrentLeague *string `json:"currentLeague"`
+	// The ranking of the user in the current league.
+	CurrentLeagueRanking *int `json:"currentLeagueRanking"`
+}
+
+type ProfileTradingPnls struct {
+	// The absolute PnL of the user over the last 30 days.
+	AbsolutePnl30D *string `json:"absolutePnl30D"`
+	// The PnL of the user over the last 30 days as a percentage of their equity.
+	PercentPnl30D *string `json:"percentPnl30D"`
+	// The trading volume of the user over the last 30 days.
+	Volume30D *string `json:"volume30D"`
+}
+
+type ProfileTradingRewards struct {
+	// The current trading rewards epoch.
+	CurEpoch *int `json:"curEpoch"`
+	// The estimated rewards of the user in the current epoch.
+	CurEpochEstimatedRewards *string `json:"curEpochEstimatedRewards"`
+	// The estimated rewards of the user in the previous epoch.
+	PrevEpochEstimatedRewards *string `json:"prevEpochEstimatedRewards"`
+}
+
+type AffiliateLink struct {
+	// The affiliate link.
+	Link string `json:"link"`
+	// The discount rate given to users referred by the link.
+	DiscountRate *string `json:"discountRate"`
+}
+
+type InsuranceFundBalance struct {
+	// The balance of the insurance fund in USDC.
+	Balance json.Number `json:"balance"`
+}